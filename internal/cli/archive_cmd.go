@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+var errAborted = errors.New("aborted")
+
+type IssueArchiveCmd struct {
+	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
+}
+
+type IssueUnarchiveCmd struct {
+	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
+}
+
+type IssueDeleteCmd struct {
+	IssueID   string `arg:"" name:"issue-id" help:"Issue ID"`
+	Permanent bool   `help:"Delete permanently instead of moving to the trash (admins only)"`
+}
+
+type issueActionResult struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	URL        string `json:"url"`
+	Action     string `json:"action"`
+}
+
+func (c *IssueArchiveCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	return runIssueAction(ctx, cmdCtx, c.IssueID, "archived", "Archive", func(client linear.API, issueID string) error {
+		return client.IssueArchive(ctx, issueID)
+	})
+}
+
+func (c *IssueUnarchiveCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	return runIssueAction(ctx, cmdCtx, c.IssueID, "unarchived", "", func(client linear.API, issueID string) error {
+		return client.IssueUnarchive(ctx, issueID)
+	})
+}
+
+func (c *IssueDeleteCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	action, verb := "deleted", "Move to the trash"
+	if c.Permanent {
+		action, verb = "permanently_deleted", "Permanently delete"
+	}
+	return runIssueAction(ctx, cmdCtx, c.IssueID, action, verb, func(client linear.API, issueID string) error {
+		return client.IssueDelete(ctx, issueID, c.Permanent)
+	})
+}
+
+func runIssueAction(ctx context.Context, cmdCtx *commandContext, issueRef, action, verb string, apply func(linear.API, string) error) error {
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	issue, err := client.Issue(ctx, issueRef)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	if verb != "" {
+		if err := confirmAction(cmdCtx, fmt.Sprintf("%s %s %q?", verb, issue.Identifier, issue.Title)); err != nil {
+			return err
+		}
+	}
+	if err := apply(client, issue.ID); err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+
+	result := issueActionResult{
+		ID:         issue.ID,
+		Identifier: issue.Identifier,
+		Title:      issue.Title,
+		URL:        issue.URL,
+		Action:     action,
+	}
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(result)
+	}
+	rows := [][]string{{result.Identifier, result.Title, result.Action}}
+	return out.PrintTable([]string{"ID", "Title", "Action"}, rows)
+}
+
+func confirmAction(cmdCtx *commandContext, question string) error {
+	if cmdCtx.global.Yes || cmdCtx.global.DryRun {
+		return nil
+	}
+	if !cmdCtx.interactive() {
+		return exitError(2, errors.New("confirmation required; pass --yes to proceed"))
+	}
+	ok, err := newPrompter(cmdCtx).confirm(question)
+	if err != nil {
+		return exitError(1, err)
+	}
+	if !ok {
+		return exitError(1, errAborted)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func TestIssueArchiveAndDelete(t *testing.T) {
+	tests := []struct {
+		name        string
+		interactive bool
+		in          string
+		args        []string
+		code        int
+		check       func(t *testing.T, api *fakeAPI, c *cliTest)
+	}{
+		{
+			name: "archive with yes",
+			args: []string{"--yes", "issue", "archive", "ENG-1"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if len(api.archived) != 1 || api.archived[0] != "issue-1" {
+					t.Fatalf("expected issue-1 archived, got %v", api.archived)
+				}
+				if !strings.Contains(c.out.String(), "archived") {
+					t.Fatalf("unexpected output %q", c.out.String())
+				}
+			},
+		},
+		{
+			name: "delete requires confirmation",
+			args: []string{"issue", "delete", "ENG-1"},
+			code: 2,
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.deleted) != 0 {
+					t.Fatalf("expected no deletes, got %v", api.deleted)
+				}
+			},
+		},
+		{
+			name:        "delete declined interactively",
+			interactive: true,
+			in:          "n\n",
+			args:        []string{"issue", "delete", "ENG-1", "--permanent"},
+			code:        1,
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if !strings.Contains(c.errOut.String(), `Permanently delete ENG-1 "Old bug"? [y/N]`) {
+					t.Fatalf("expected confirmation prompt, got %q", c.errOut.String())
+				}
+				if len(api.deleted) != 0 {
+					t.Fatalf("expected no deletes, got %v", api.deleted)
+				}
+			},
+		},
+		{
+			name:        "delete confirmed interactively",
+			interactive: true,
+			in:          "y\n",
+			args:        []string{"issue", "delete", "ENG-1", "--permanent"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.deleted) != 1 || api.deleted[0] != "issue-1 (permanent)" {
+					t.Fatalf("expected permanent delete, got %v", api.deleted)
+				}
+			},
+		},
+		{
+			name: "unarchive skips confirmation",
+			args: []string{"issue", "unarchive", "ENG-1"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.unarchived) != 1 {
+					t.Fatalf("expected unarchive, got %v", api.unarchived)
+				}
+			},
+		},
+		{
+			name: "list includes archived",
+			args: []string{"issue", "list", "--include-archived"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if api.issuesFilter == nil || !api.issuesFilter.IncludeArchived {
+					t.Fatalf("expected IncludeArchived filter, got %+v", api.issuesFilter)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI(linear.IssueDetail{ID: "issue-1", Identifier: "ENG-1", Title: "Old bug"})
+			c := newCLITest(t, api)
+			c.deps.Interactive = tt.interactive
+			c.deps.In = strings.NewReader(tt.in)
+			c.run(tt.code, tt.args...)
+			tt.check(t, api, c)
+		})
+	}
+}
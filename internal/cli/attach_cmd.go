@@ -0,0 +1,189 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+type IssueAttachCmd struct {
+	IssueID string   `arg:"" name:"issue-id" help:"Issue ID"`
+	Files   []string `arg:"" name:"file" help:"Files to upload" type:"path"`
+	Comment string   `help:"Post the files in a new comment with this text instead of adding them to the description"`
+}
+
+type localUpload struct {
+	Path        string
+	Name        string
+	ContentType string
+	Size        int64
+}
+
+type uploadedFile struct {
+	Name        string `json:"name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	URL         string `json:"url"`
+}
+
+type attachResult struct {
+	IssueID    string         `json:"issue_id"`
+	Identifier string         `json:"identifier"`
+	CommentID  string         `json:"comment_id,omitempty"`
+	Files      []uploadedFile `json:"files"`
+}
+
+func (c *IssueAttachCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	files, err := prepareUploads(c.Files)
+	if err != nil {
+		return err
+	}
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	issue, err := client.Issue(ctx, c.IssueID)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+
+	uploaded, err := uploadFiles(ctx, client, files)
+	if err != nil {
+		return err
+	}
+	result := attachResult{IssueID: issue.ID, Identifier: issue.Identifier, Files: uploaded}
+	markdown := uploadMarkdown(uploaded)
+	if c.Comment != "" {
+		result.CommentID, err = client.IssueComment(ctx, issue.ID, appendMarkdown(c.Comment, markdown))
+	} else {
+		input := linear.IssueUpdateInput{Description: linear.Set(appendMarkdown(issue.Description, markdown))}
+		_, err = client.IssueUpdate(ctx, issue.ID, input)
+	}
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(result)
+	}
+	rows := make([][]string, 0, len(uploaded))
+	for _, file := range uploaded {
+		rows = append(rows, []string{file.Name, file.ContentType, formatBytes(file.Size), file.URL})
+	}
+	return out.PrintTable([]string{"File", "Type", "Size", "URL"}, rows)
+}
+
+// prepareUploads checks every file before anything is uploaded so a bad path
+// doesn't leave some files uploaded and others not.
+func prepareUploads(paths []string) ([]localUpload, error) {
+	files := make([]localUpload, 0, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, exitError(1, err)
+		}
+		if info.IsDir() {
+			return nil, exitError(2, fmt.Errorf("%s is a directory", path))
+		}
+		if info.Size() == 0 {
+			return nil, exitError(2, fmt.Errorf("%s is empty", path))
+		}
+		if info.Size() > linear.MaxUploadSize {
+			return nil, exitError(2, fmt.Errorf("%s is %s, larger than the %s upload limit", path, formatBytes(info.Size()), formatBytes(linear.MaxUploadSize)))
+		}
+		contentType, err := detectContentType(path)
+		if err != nil {
+			return nil, exitError(1, err)
+		}
+		files = append(files, localUpload{Path: path, Name: filepath.Base(path), ContentType: contentType, Size: info.Size()})
+	}
+	return files, nil
+}
+
+// detectContentType prefers the file extension and falls back to sniffing
+// the first bytes for files without a known one.
+func detectContentType(path string) (string, error) {
+	if contentType := mime.TypeByExtension(strings.ToLower(filepath.Ext(path))); contentType != "" {
+		return contentType, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+	return http.DetectContentType(head[:n]), nil
+}
+
+func uploadFiles(ctx context.Context, client linear.API, files []localUpload) ([]uploadedFile, error) {
+	uploaded := make([]uploadedFile, 0, len(files))
+	for _, file := range files {
+		assetURL, err := uploadFile(ctx, client, file)
+		if err != nil {
+			return nil, exitError(mapErrorToExitCode(err), fmt.Errorf("upload %s: %w", file.Name, err))
+		}
+		uploaded = append(uploaded, uploadedFile{Name: file.Name, ContentType: file.ContentType, Size: file.Size, URL: assetURL})
+	}
+	return uploaded, nil
+}
+
+func uploadFile(ctx context.Context, client linear.API, file localUpload) (string, error) {
+	f, err := os.Open(file.Path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return client.UploadFile(ctx, file.Name, file.ContentType, file.Size, f)
+}
+
+// linkTextEscaper escapes the characters that would end or break markdown
+// link text.
+var linkTextEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`)
+
+// uploadMarkdown links each file, inlining images so Linear renders them.
+func uploadMarkdown(files []uploadedFile) string {
+	lines := make([]string, 0, len(files))
+	for _, file := range files {
+		link := fmt.Sprintf("[%s](%s)", linkTextEscaper.Replace(file.Name), file.URL)
+		if strings.HasPrefix(file.ContentType, "image/") {
+			link = "!" + link
+		}
+		lines = append(lines, link)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func appendMarkdown(text, markdown string) string {
+	text = strings.TrimRight(text, " \t\n")
+	if text == "" {
+		return markdown
+	}
+	if markdown == "" {
+		return text
+	}
+	return text + "\n\n" + markdown
+}
+
+func formatBytes(size int64) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func writeTestFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestIssueAttach(t *testing.T) {
+	shot := writeTestFile(t, "shot.png", []byte("\x89PNG\r\n\x1a\nrest"))
+	log := writeTestFile(t, "crash", []byte("plain text log"))
+	empty := writeTestFile(t, "empty.txt", nil)
+	notes := writeTestFile(t, "notes.md", []byte("# notes"))
+
+	tests := []struct {
+		name  string
+		args  []string
+		code  int
+		check func(t *testing.T, api *fakeAPI, c *cliTest)
+	}{
+		{
+			name: "appends to description",
+			args: []string{"issue", "attach", "ENG-1", shot, log},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if strings.Join(api.uploads, ",") != "shot.png image/png,crash text/plain; charset=utf-8" {
+					t.Fatalf("unexpected uploads %v", api.uploads)
+				}
+				if len(api.updates) != 1 || len(api.comments) != 0 {
+					t.Fatalf("expected one description update, got %v / %v", api.updates, api.comments)
+				}
+				description, _ := api.updates[0].Input.Description.Value()
+				want := "Steps to reproduce\n\n![shot.png](https://uploads.linear.app/x/shot.png)\n[crash](https://uploads.linear.app/x/crash)"
+				if description != want {
+					t.Fatalf("unexpected description %q", description)
+				}
+				if !strings.Contains(c.out.String(), "image/png") {
+					t.Fatalf("expected upload table, got %q", c.out.String())
+				}
+			},
+		},
+		{
+			name: "posts a comment",
+			args: []string{"issue", "attach", "ENG-1", shot, "--comment", "repro"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.updates) != 0 || len(api.comments) != 1 {
+					t.Fatalf("expected one comment, got %v / %v", api.updates, api.comments)
+				}
+				if api.comments[0] != "repro\n\n![shot.png](https://uploads.linear.app/x/shot.png)" {
+					t.Fatalf("unexpected comment %q", api.comments[0])
+				}
+			},
+		},
+		{
+			name: "rejects empty file before uploading",
+			args: []string{"issue", "attach", "ENG-1", shot, empty},
+			code: 2,
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if !strings.Contains(c.errOut.String(), "is empty") {
+					t.Fatalf("unexpected error %q", c.errOut.String())
+				}
+				if len(api.uploads) != 0 || len(api.updates) != 0 {
+					t.Fatalf("expected nothing uploaded, got %v", api.uploads)
+				}
+			},
+		},
+		{
+			name: "create attaches to the description",
+			args: []string{"issue", "create", "--team", "ENG", "--title", "Crash", "--description", "See file", "--attach", notes},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.creates) != 1 || api.creates[0].Description != "See file\n\n[notes.md](https://uploads.linear.app/x/notes.md)" {
+					t.Fatalf("unexpected creates %v", api.creates)
+				}
+			},
+		},
+		{
+			name: "comment attaches to the body",
+			args: []string{"issue", "comment", "ENG-1", "--attach", notes},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.comments) != 1 || api.comments[0] != "[notes.md](https://uploads.linear.app/x/notes.md)" {
+					t.Fatalf("unexpected comments %v", api.comments)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI(linear.IssueDetail{ID: "issue-1", Identifier: "ENG-1", Description: "Steps to reproduce\n"})
+			c := newCLITest(t, api)
+			c.run(tt.code, tt.args...)
+			tt.check(t, api, c)
+		})
+	}
+}
+
+func TestUploadMarkdownEscapesLinkText(t *testing.T) {
+	got := uploadMarkdown([]uploadedFile{
+		{Name: "shot [1].png", ContentType: "image/png", URL: "https://uploads.linear.app/x/a"},
+		{Name: `notes\].txt`, ContentType: "text/plain", URL: "https://uploads.linear.app/x/b"},
+	})
+	want := `![shot \[1\].png](https://uploads.linear.app/x/a)` + "\n" + `[notes\\\].txt](https://uploads.linear.app/x/b)`
+	if got != want {
+		t.Fatalf("uploadMarkdown() = %q, want %q", got, want)
+	}
+}
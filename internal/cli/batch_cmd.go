@@ -0,0 +1,447 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+var batchRefPattern = regexp.MustCompile(`\$(\d+)\.([a-z_]+)`)
+
+type BatchCmd struct {
+	File            string `arg:"" help:"NDJSON file of operations, or '-' for stdin"`
+	ContinueOnError bool   `name:"continue-on-error" help:"Keep going after a failed operation"`
+	Results         string `help:"Write per-operation results as NDJSON to this file"`
+}
+
+type batchOp struct {
+	Op          string `json:"op"`
+	Team        string `json:"team"`
+	Issue       string `json:"issue"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Body        string `json:"body"`
+	Assignee    string `json:"assignee"`
+	State       string `json:"state"`
+	Priority    string `json:"priority"`
+	Labels      string `json:"labels"`
+	Project     string `json:"project"`
+	Cycle       string `json:"cycle"`
+	Parent      string `json:"parent"`
+	Estimate    string `json:"estimate"`
+	Due         string `json:"due"`
+	Blocks      string `json:"blocks"`
+	BlockedBy   string `json:"blocked_by"`
+	Related     string `json:"related"`
+	DuplicateOf string `json:"duplicate_of"`
+
+	line   int
+	fields map[string]string
+}
+
+type batchResult struct {
+	Index      int    `json:"index"`
+	Line       int    `json:"line"`
+	Op         string `json:"op"`
+	Status     string `json:"status"`
+	ID         string `json:"id,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+	URL        string `json:"url,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+func (c *BatchCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	var r io.Reader = cmdCtx.deps.In
+	if c.File != "-" {
+		file, err := os.Open(c.File)
+		if err != nil {
+			return exitError(1, fmt.Errorf("open batch file: %w", err))
+		}
+		defer file.Close()
+		r = file
+	}
+	ops, err := parseBatchOps(r)
+	if err != nil {
+		return exitError(2, err)
+	}
+
+	results := make([]batchResult, len(ops))
+	for i, op := range ops {
+		results[i] = batchResult{Index: i + 1, Line: op.line, Op: op.Op, Status: "skipped"}
+	}
+
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	client = newCachingAPI(client)
+
+	failed := 0
+	for i, op := range ops {
+		resolved, err := op.resolveRefs(results[:i])
+		if err == nil {
+			err = resolved.execute(ctx, client, &results[i])
+		}
+		if err != nil {
+			failed++
+			results[i].Status = "failed"
+			results[i].Error = err.Error()
+			if !c.ContinueOnError {
+				break
+			}
+			continue
+		}
+		results[i].Status = "ok"
+	}
+
+	if c.Results != "" {
+		if err := writeBatchResults(c.Results, results); err != nil {
+			return exitError(1, err)
+		}
+	}
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		if err := out.PrintJSON(results); err != nil {
+			return err
+		}
+	} else {
+		rows := make([][]string, 0, len(results))
+		for _, result := range results {
+			ref := result.Identifier
+			if ref == "" {
+				ref = result.ID
+			}
+			rows = append(rows, []string{strconv.Itoa(result.Index), result.Op, result.Status, ref, result.Error})
+		}
+		if err := out.PrintTable([]string{"#", "Op", "Status", "Result", "Error"}, rows); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return exitError(1, fmt.Errorf("%d of %d operations failed", failed, len(ops)))
+	}
+	return nil
+}
+
+func parseBatchOps(r io.Reader) ([]batchOp, error) {
+	ops := []batchOp{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		op, err := parseBatchOp(text, len(ops)+1)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		op.line = line
+		ops = append(ops, op)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read batch file: %w", err)
+	}
+	if len(ops) == 0 {
+		return nil, errors.New("batch file has no operations")
+	}
+	return ops, nil
+}
+
+func parseBatchOp(text string, index int) (batchOp, error) {
+	var raw map[string]any
+	if err := json.Unmarshal([]byte(text), &raw); err != nil {
+		return batchOp{}, fmt.Errorf("invalid JSON: %w", err)
+	}
+	fields := map[string]string{}
+	for key, value := range raw {
+		switch v := value.(type) {
+		case string:
+			fields[key] = v
+		case float64:
+			fields[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		case []any:
+			parts := make([]string, 0, len(v))
+			for _, item := range v {
+				s, ok := item.(string)
+				if !ok {
+					return batchOp{}, fmt.Errorf("field %q must be a list of strings", key)
+				}
+				parts = append(parts, s)
+			}
+			fields[key] = strings.Join(parts, ",")
+		case nil:
+		default:
+			return batchOp{}, fmt.Errorf("field %q must be a string, number, or list", key)
+		}
+	}
+	op, err := decodeBatchFields(fields)
+	if err != nil {
+		return batchOp{}, err
+	}
+	for key, value := range fields {
+		for _, match := range batchRefPattern.FindAllStringSubmatch(value, -1) {
+			n, _ := strconv.Atoi(match[1])
+			if n < 1 || n >= index {
+				return batchOp{}, fmt.Errorf("field %q: %s does not refer to an earlier operation", key, match[0])
+			}
+			switch match[2] {
+			case "id", "identifier", "url":
+			default:
+				return batchOp{}, fmt.Errorf("field %q: unknown reference field %q", key, match[2])
+			}
+		}
+	}
+	if err := op.validate(); err != nil {
+		return batchOp{}, err
+	}
+	return op, nil
+}
+
+func decodeBatchFields(fields map[string]string) (batchOp, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return batchOp{}, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var op batchOp
+	if err := dec.Decode(&op); err != nil {
+		return batchOp{}, err
+	}
+	op.fields = fields
+	return op, nil
+}
+
+func (op batchOp) validate() error {
+	switch op.Op {
+	case "create":
+		if op.Team == "" || op.Title == "" {
+			return errors.New("create requires team and title")
+		}
+	case "update":
+		if op.Issue == "" {
+			return errors.New("update requires issue")
+		}
+	case "comment":
+		if op.Issue == "" || op.Body == "" {
+			return errors.New("comment requires issue and body")
+		}
+	case "relate":
+		if op.Issue == "" {
+			return errors.New("relate requires issue")
+		}
+		if op.Blocks == "" && op.BlockedBy == "" && op.Related == "" && op.DuplicateOf == "" {
+			return errors.New("relate requires blocks, blocked_by, related, or duplicate_of")
+		}
+	case "":
+		return errors.New("missing op")
+	default:
+		return fmt.Errorf("unknown op %q (expected create, update, comment, or relate)", op.Op)
+	}
+	return nil
+}
+
+func (op batchOp) resolveRefs(results []batchResult) (batchOp, error) {
+	fields := make(map[string]string, len(op.fields))
+	for key, value := range op.fields {
+		var refErr error
+		fields[key] = batchRefPattern.ReplaceAllStringFunc(value, func(ref string) string {
+			match := batchRefPattern.FindStringSubmatch(ref)
+			n, _ := strconv.Atoi(match[1])
+			result := results[n-1]
+			if result.Status != "ok" {
+				refErr = fmt.Errorf("%s refers to operation %d, which did not succeed", ref, n)
+				return ref
+			}
+			switch match[2] {
+			case "identifier":
+				if result.Identifier != "" {
+					return result.Identifier
+				}
+			case "url":
+				if result.URL != "" {
+					return result.URL
+				}
+			}
+			if match[2] != "id" || result.ID == "" {
+				refErr = fmt.Errorf("%s is not available from operation %d", ref, n)
+				return ref
+			}
+			return result.ID
+		})
+		if refErr != nil {
+			return batchOp{}, refErr
+		}
+	}
+	resolved, err := decodeBatchFields(fields)
+	if err != nil {
+		return batchOp{}, err
+	}
+	resolved.line = op.line
+	return resolved, nil
+}
+
+func (op batchOp) execute(ctx context.Context, client linear.API, result *batchResult) error {
+	switch op.Op {
+	case "create":
+		teamID, err := client.ResolveTeamID(ctx, op.Team)
+		if err != nil {
+			return err
+		}
+		cmd := IssueCreateCmd{
+			Title:     op.Title,
+			Assignee:  op.Assignee,
+			State:     op.State,
+			Priority:  op.Priority,
+			Project:   op.Project,
+			Cycle:     op.Cycle,
+			Parent:    op.Parent,
+			Labels:    op.Labels,
+			Estimate:  op.Estimate,
+			Due:       op.Due,
+			Blocks:    op.Blocks,
+			BlockedBy: op.BlockedBy,
+		}
+		issue, err := cmd.create(ctx, client, teamID, op.Description)
+		if err != nil {
+			return err
+		}
+		result.ID, result.Identifier, result.URL = issue.ID, issue.Identifier, issue.URL
+	case "update":
+		issueID, err := client.ResolveIssueID(ctx, op.Issue)
+		if err != nil {
+			return err
+		}
+		flags := IssueUpdateFlags{
+			Team:      op.Team,
+			Title:     op.Title,
+			Assignee:  op.Assignee,
+			State:     op.State,
+			Priority:  op.Priority,
+			Project:   op.Project,
+			Cycle:     op.Cycle,
+			Labels:    op.Labels,
+			Estimate:  op.Estimate,
+			Due:       op.Due,
+			Parent:    op.Parent,
+			Blocks:    op.Blocks,
+			BlockedBy: op.BlockedBy,
+		}
+		issue, _, err := flags.apply(ctx, client, issueID, op.Description, nil)
+		if err != nil {
+			return err
+		}
+		result.ID, result.Identifier, result.URL = issue.ID, issue.Identifier, issue.URL
+	case "comment":
+		issueID, err := client.ResolveIssueID(ctx, op.Issue)
+		if err != nil {
+			return err
+		}
+		commentID, err := client.IssueComment(ctx, issueID, op.Body)
+		if err != nil {
+			return err
+		}
+		result.ID = commentID
+	case "relate":
+		issueID, err := client.ResolveIssueID(ctx, op.Issue)
+		if err != nil {
+			return err
+		}
+		if err := applyIssueRelations(ctx, client, issueID, issueRelationFlags{
+			Blocks:      op.Blocks,
+			BlockedBy:   op.BlockedBy,
+			Related:     op.Related,
+			DuplicateOf: op.DuplicateOf,
+		}, true); err != nil {
+			return err
+		}
+		result.ID = issueID
+	}
+	return nil
+}
+
+func writeBatchResults(path string, results []batchResult) error {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	for _, result := range results {
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	}
+	if err := os.WriteFile(path, b.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("write results: %w", err)
+	}
+	return nil
+}
+
+type cachingAPI struct {
+	linear.API
+	ids    map[string]string
+	labels map[string][]string
+}
+
+func newCachingAPI(api linear.API) *cachingAPI {
+	return &cachingAPI{API: api, ids: map[string]string{}, labels: map[string][]string{}}
+}
+
+func (c *cachingAPI) memo(key string, resolve func() (string, error)) (string, error) {
+	if id, ok := c.ids[key]; ok {
+		return id, nil
+	}
+	id, err := resolve()
+	if err != nil {
+		return "", err
+	}
+	c.ids[key] = id
+	return id, nil
+}
+
+func (c *cachingAPI) ResolveTeamID(ctx context.Context, keyOrID string) (string, error) {
+	return c.memo("team\x00"+keyOrID, func() (string, error) { return c.API.ResolveTeamID(ctx, keyOrID) })
+}
+
+func (c *cachingAPI) ResolveUserID(ctx context.Context, value string) (string, error) {
+	return c.memo("user\x00"+value, func() (string, error) { return c.API.ResolveUserID(ctx, value) })
+}
+
+func (c *cachingAPI) ResolveStateID(ctx context.Context, teamID, value string) (string, error) {
+	return c.memo("state\x00"+teamID+"\x00"+value, func() (string, error) { return c.API.ResolveStateID(ctx, teamID, value) })
+}
+
+func (c *cachingAPI) ResolveProjectID(ctx context.Context, value string) (string, error) {
+	return c.memo("project\x00"+value, func() (string, error) { return c.API.ResolveProjectID(ctx, value) })
+}
+
+func (c *cachingAPI) ResolveCycleID(ctx context.Context, teamID, value string) (string, error) {
+	return c.memo("cycle\x00"+teamID+"\x00"+value, func() (string, error) { return c.API.ResolveCycleID(ctx, teamID, value) })
+}
+
+func (c *cachingAPI) ResolveIssueID(ctx context.Context, value string) (string, error) {
+	return c.memo("issue\x00"+value, func() (string, error) { return c.API.ResolveIssueID(ctx, value) })
+}
+
+func (c *cachingAPI) ResolveLabelIDs(ctx context.Context, teamID string, labels []string) ([]string, error) {
+	key := teamID + "\x00" + strings.Join(labels, ",")
+	if ids, ok := c.labels[key]; ok {
+		return ids, nil
+	}
+	ids, err := c.API.ResolveLabelIDs(ctx, teamID, labels)
+	if err != nil {
+		return nil, err
+	}
+	c.labels[key] = ids
+	return ids, nil
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+const batchOps = `{"op":"create","team":"ENG","title":"Migrate billing","labels":["bug","infra"],"priority":2}
+# comments and blank lines are skipped
+
+{"op":"comment","issue":"$1.id","body":"Created as $1.identifier"}
+{"op":"relate","issue":"$1.id","blocks":"ENG-5"}
+`
+
+func TestBatchRunsOperationsInOrder(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, out, errOut := newTestDeps(api)
+	deps.In = strings.NewReader(batchOps)
+	results := filepath.Join(t.TempDir(), "results.ndjson")
+
+	code := ExecuteWith(deps, []string{"batch", "-", "--results", results})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.creates) != 1 || strings.Join(api.creates[0].LabelIDs, ",") != "label-bug,label-infra" {
+		t.Fatalf("unexpected creates %+v", api.creates)
+	}
+	if len(api.comments) != 1 || api.comments[0] != "Created as ENG-100" {
+		t.Fatalf("unexpected comments %v", api.comments)
+	}
+	if len(api.createdRelations) != 1 || api.createdRelations[0].IssueID != "issue-new" || api.createdRelations[0].RelatedIssueID != "ENG-5" {
+		t.Fatalf("unexpected relations %+v", api.createdRelations)
+	}
+	if !strings.Contains(out.String(), "ENG-100") {
+		t.Fatalf("unexpected output %q", out.String())
+	}
+
+	data, err := os.ReadFile(results)
+	if err != nil {
+		t.Fatalf("read results: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[0], `"identifier":"ENG-100"`) || !strings.Contains(lines[1], `"line":4`) {
+		t.Fatalf("unexpected results file:\n%s", data)
+	}
+}
+
+func TestBatchStopsOnFirstFailure(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, out, _ := newTestDeps(api)
+	deps.In = strings.NewReader(`{"op":"update","issue":"ENG-1","priority":"urgentish"}
+{"op":"comment","issue":"ENG-1","body":"hi"}
+`)
+
+	if code := ExecuteWith(deps, []string{"--json", "batch", "-"}); code != 1 {
+		t.Fatalf("expected exit 1, got %d", code)
+	}
+	if len(api.comments) != 0 {
+		t.Fatalf("expected comment to be skipped, got %v", api.comments)
+	}
+	if !strings.Contains(out.String(), `"status": "skipped"`) {
+		t.Fatalf("expected skipped operation, got %s", out.String())
+	}
+
+	deps.In = strings.NewReader(`{"op":"update","issue":"ENG-1","priority":"urgentish"}
+{"op":"comment","issue":"ENG-1","body":"hi"}
+{"op":"comment","issue":"$1.id","body":"never"}
+`)
+	if code := ExecuteWith(deps, []string{"batch", "-", "--continue-on-error"}); code != 1 {
+		t.Fatalf("expected exit 1, got %d", code)
+	}
+	if len(api.comments) != 1 || api.comments[0] != "hi" {
+		t.Fatalf("expected only the independent comment, got %v", api.comments)
+	}
+}
+
+func TestBatchDryRunPrintsResolvedMutations(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{strictIssueIDs: true, issues: map[string]linear.IssueDetail{
+		"ENG-5": {ID: "issue-5", Identifier: "ENG-5"},
+	}}
+	deps, out, errOut := newTestDeps(api)
+	deps.In = strings.NewReader(batchOps)
+
+	if code := ExecuteWith(deps, []string{"batch", "-", "--dry-run"}); code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.creates) != 0 || len(api.comments) != 0 || len(api.createdRelations) != 0 {
+		t.Fatal("expected no mutations in dry run")
+	}
+	text := out.String()
+	for _, want := range []string{
+		"# mutation 1 of 3 (not sent)",
+		`"labelIds": [`,
+		`"label-bug"`,
+		"commentCreate(input: $input)",
+		`"body": "Created as dry-run"`,
+		`"issueId": "dry-run"`,
+		`"relatedIssueId": "issue-5"`,
+	} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("expected %q in output:\n%s", want, text)
+		}
+	}
+}
+
+func TestBatchRejectsInvalidOperations(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	for _, input := range []string{
+		`{"op":"delete","issue":"ENG-1"}`,
+		`{"op":"create","team":"ENG"}`,
+		`{"op":"comment","issue":"$1.id","body":"forward ref"}`,
+		`{"op":"update","issue":"ENG-1","colour":"red"}`,
+		`not json`,
+	} {
+		api := &fakeAPI{}
+		deps, _, errOut := newTestDeps(api)
+		deps.In = strings.NewReader(input)
+		if code := ExecuteWith(deps, []string{"batch", "-"}); code != 2 {
+			t.Fatalf("%s: expected exit 2, got %d", input, code)
+		}
+		if !strings.Contains(errOut.String(), "line 1") {
+			t.Fatalf("%s: expected line number in error, got %q", input, errOut.String())
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+const bulkPageSize = 100
+
+type IssueBulkUpdateCmd struct {
+	Where            map[string]string `help:"Select issues by key=value (team, assignee, unassigned, state, label, project, cycle, search, priority, parent)" required:""`
+	Limit            int               `help:"Maximum number of issues to update" default:"250"`
+	Concurrency      int               `help:"Number of concurrent updates" default:"4"`
+	IssueUpdateFlags `embed:""`
+}
+
+type bulkResult struct {
+	Issue  string `json:"issue"`
+	Status string `json:"status"`
+	URL    string `json:"url,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func (c *IssueBulkUpdateCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	if err := c.check(); err != nil {
+		return err
+	}
+	description, err := readOptionalBody(c.Description, cmdCtx.deps.In)
+	if err != nil {
+		return exitError(1, err)
+	}
+	if err := c.checkBulk(description); err != nil {
+		return err
+	}
+	opts, err := whereFilterOptions(c.Where)
+	if err != nil {
+		return exitError(2, err)
+	}
+	filter, err := resolveIssueFilter(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+	issues, err := collectIssues(ctx, client, filter, c.Limit)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	return runBulkUpdate(ctx, cmdCtx, client, &c.IssueUpdateFlags, issues, description, c.Concurrency)
+}
+
+func (c *IssueUpdateCmd) runFromStdin(ctx context.Context, cmdCtx *commandContext, client linear.API) error {
+	if c.IssueID != "" {
+		return exitError(2, errors.New("--from-stdin cannot be combined with an issue ID argument"))
+	}
+	if c.Edit {
+		return exitError(2, errors.New("--from-stdin cannot be combined with --edit"))
+	}
+	if c.Description == "-" {
+		return exitError(2, errors.New("--from-stdin cannot be combined with --description -"))
+	}
+	if err := c.check(); err != nil {
+		return err
+	}
+	if err := c.checkBulk(c.Description); err != nil {
+		return err
+	}
+	// Stdin carries the issue list, so the confirmation prompt goes to the
+	// controlling terminal; without one, --yes is required before any work.
+	confirmCtx := cmdCtx
+	if !cmdCtx.global.Yes && !cmdCtx.global.DryRun {
+		ttyCtx, tty, err := cmdCtx.withTerminal()
+		if err != nil {
+			return exitError(2, errors.New("--from-stdin needs --yes when no terminal is available to confirm"))
+		}
+		defer tty.Close()
+		confirmCtx = ttyCtx
+	}
+	issues, err := readIssueRefs(cmdCtx.deps.In)
+	if err != nil {
+		return exitError(2, err)
+	}
+	return runBulkUpdate(ctx, confirmCtx, client, &c.IssueUpdateFlags, issues, c.Description, c.Concurrency)
+}
+
+// checkBulk parses the flag values once, before any issue is listed or
+// updated, so a bad value is a usage error rather than a failure per issue.
+func (c *IssueUpdateFlags) checkBulk(description string) error {
+	input, err := c.localInput(description)
+	if err != nil {
+		return exitError(2, err)
+	}
+	if input.IsEmpty() && !c.hasLookupChanges() {
+		return exitError(2, errors.New("no changes requested; pass at least one field to update"))
+	}
+	return nil
+}
+
+func whereFilterOptions(where map[string]string) (issueFilterOptions, error) {
+	opts := issueFilterOptions{}
+	keys := make([]string, 0, len(where))
+	for key := range where {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := where[key]
+		switch strings.ToLower(key) {
+		case "team":
+			opts.Team = value
+		case "assignee":
+			opts.Assignee = value
+		case "unassigned":
+			unassigned, err := strconv.ParseBool(value)
+			if err != nil {
+				return opts, fmt.Errorf("invalid value for unassigned: %q", value)
+			}
+			opts.Unassigned = unassigned
+		case "state":
+			opts.State = value
+		case "label", "labels":
+			opts.Labels = value
+		case "project":
+			opts.Project = value
+		case "cycle":
+			opts.Cycle = value
+		case "search":
+			opts.Search = value
+		case "priority":
+			opts.Priority = value
+		case "parent":
+			opts.Parent = value
+		case "subscriber":
+			opts.Subscriber = value
+		default:
+			return opts, fmt.Errorf("unknown --where key %q", key)
+		}
+	}
+	if opts.Assignee != "" && opts.Unassigned {
+		return opts, errors.New("assignee and unassigned cannot be combined")
+	}
+	return opts, nil
+}
+
+func collectIssues(ctx context.Context, client linear.API, filter linear.IssueFilter, limit int) ([]linear.IssueSummary, error) {
+	issues := []linear.IssueSummary{}
+	after := ""
+	for limit <= 0 || len(issues) < limit {
+		size := bulkPageSize
+		if limit > 0 && limit-len(issues) < size {
+			size = limit - len(issues)
+		}
+		page, err := client.Issues(ctx, filter, size, after)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			break
+		}
+		after = page.PageInfo.EndCursor
+	}
+	return issues, nil
+}
+
+func readIssueRefs(r io.Reader) ([]linear.IssueSummary, error) {
+	issues := []linear.IssueSummary{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.HasPrefix(line, "{") {
+			var issue linear.IssueSummary
+			if err := json.Unmarshal([]byte(line), &issue); err != nil {
+				return nil, fmt.Errorf("invalid NDJSON line: %w", err)
+			}
+			if issue.ID == "" && issue.Identifier == "" {
+				return nil, fmt.Errorf("NDJSON line has no id or identifier: %s", line)
+			}
+			issues = append(issues, issue)
+			continue
+		}
+		issues = append(issues, linear.IssueSummary{Identifier: line})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read stdin: %w", err)
+	}
+	if len(issues) == 0 {
+		return nil, errors.New("no issue IDs on stdin")
+	}
+	return issues, nil
+}
+
+func runBulkUpdate(ctx context.Context, cmdCtx *commandContext, client linear.API, flags *IssueUpdateFlags, issues []linear.IssueSummary, description string, concurrency int) error {
+	if len(issues) == 0 {
+		_, _ = fmt.Fprintln(cmdCtx.deps.Err, "No issues to update")
+		return nil
+	}
+	if !cmdCtx.global.Yes {
+		preview := output{Out: cmdCtx.deps.Err}
+		rows := make([][]string, 0, len(issues))
+		for _, issue := range issues {
+			rows = append(rows, []string{issueRef(issue), issue.Title, issue.State, issue.Assignee})
+		}
+		if err := preview.PrintTable([]string{"ID", "Title", "State", "Assignee"}, rows); err != nil {
+			return err
+		}
+	}
+	if err := confirmAction(cmdCtx, fmt.Sprintf("Update %d issues?", len(issues))); err != nil {
+		return err
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	results := make([]bulkResult, len(issues))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(issues); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = updateOne(ctx, client, flags, issues[i], description)
+			}
+		}()
+	}
+	for i := range issues {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	failed := 0
+	for _, result := range results {
+		if result.Status != "updated" {
+			failed++
+		}
+	}
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		if err := out.PrintJSON(results); err != nil {
+			return err
+		}
+	} else {
+		rows := make([][]string, 0, len(results))
+		for _, result := range results {
+			detail := result.URL
+			if result.Error != "" {
+				detail = result.Error
+			}
+			rows = append(rows, []string{result.Issue, result.Status, detail})
+		}
+		if err := out.PrintTable([]string{"ID", "Status", "Detail"}, rows); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return exitError(1, fmt.Errorf("%d of %d issues failed to update", failed, len(results)))
+	}
+	return nil
+}
+
+func updateOne(ctx context.Context, client linear.API, flags *IssueUpdateFlags, issue linear.IssueSummary, description string) bulkResult {
+	result := bulkResult{Issue: issueRef(issue), Status: "failed"}
+	issueID := issue.ID
+	if issueID == "" {
+		resolved, err := client.ResolveIssueID(ctx, issue.Identifier)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		issueID = resolved
+	}
+	updated, _, err := flags.apply(ctx, client, issueID, description, nil)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Status = "updated"
+	result.URL = updated.URL
+	return result
+}
+
+func issueRef(issue linear.IssueSummary) string {
+	if issue.Identifier != "" {
+		return issue.Identifier
+	}
+	return issue.ID
+}
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func updatedIDs(api *fakeAPI) []string {
+	ids := []string{}
+	for _, update := range api.updates {
+		ids = append(ids, update.IssueID)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestIssueUpdateFromStdin(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, out, errOut := newTestDeps(api)
+	deps.In = strings.NewReader("ENG-1\n\n{\"id\":\"issue-2\",\"identifier\":\"ENG-2\",\"title\":\"Two\"}\n")
+
+	code := ExecuteWith(deps, []string{"--yes", "issue", "update", "--from-stdin", "--priority", "high"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if got := strings.Join(updatedIDs(api), ","); got != "ENG-1,issue-2" {
+		t.Fatalf("unexpected updates %s", got)
+	}
+	for _, update := range api.updates {
+		if priority, _ := update.Input.Priority.Value(); priority != linear.PriorityHigh {
+			t.Fatalf("expected high priority, got %v", priority)
+		}
+	}
+	if !strings.Contains(out.String(), "updated") {
+		t.Fatalf("expected report, got %q", out.String())
+	}
+}
+
+func TestIssueUpdateFromStdinRequiresYes(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, _, errOut := newTestDeps(api)
+	deps.In = strings.NewReader("ENG-1\n")
+
+	if code := ExecuteWith(deps, []string{"issue", "update", "--from-stdin", "--priority", "high"}); code != 2 {
+		t.Fatalf("expected exit 2, got %d", code)
+	}
+	if !strings.Contains(errOut.String(), "--yes") || strings.Contains(errOut.String(), "ENG-1") {
+		t.Fatalf("expected an up-front --yes error without a preview, got %q", errOut.String())
+	}
+	if len(api.updates) != 0 {
+		t.Fatalf("expected no updates, got %d", len(api.updates))
+	}
+}
+
+func TestIssueUpdateFromStdinConfirmsOnTerminal(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, _, errOut := newTestDeps(api)
+	deps.In = strings.NewReader("ENG-1\nENG-2\n")
+	deps.Terminal = func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("y\n")), nil
+	}
+
+	if code := ExecuteWith(deps, []string{"issue", "update", "--from-stdin", "--priority", "high"}); code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if !strings.Contains(errOut.String(), "ENG-2") || !strings.Contains(errOut.String(), "Update 2 issues?") {
+		t.Fatalf("expected preview and prompt on stderr, got %q", errOut.String())
+	}
+	if got := strings.Join(updatedIDs(api), ","); got != "ENG-1,ENG-2" {
+		t.Fatalf("unexpected updates %s", got)
+	}
+}
+
+func TestIssueUpdateFromStdinRejectsBadFlagsUpFront(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	for _, args := range [][]string{
+		{"--priority", "bogus"},
+		{"--due", "tomorrow"},
+		{"--estimate", "lots"},
+		{},
+	} {
+		api := &fakeAPI{}
+		deps, _, errOut := newTestDeps(api)
+		deps.In = strings.NewReader("ENG-1\nENG-2\nENG-3\n")
+
+		code := ExecuteWith(deps, append([]string{"--yes", "issue", "update", "--from-stdin"}, args...))
+		if code != 2 {
+			t.Fatalf("%v: expected exit 2, got %d (stderr: %s)", args, code, errOut.String())
+		}
+		if len(api.updates) != 0 || strings.Contains(errOut.String(), "ENG-1") {
+			t.Fatalf("%v: expected no work before the error, got %d updates (stderr: %q)", args, len(api.updates), errOut.String())
+		}
+	}
+}
+
+func TestIssueBulkUpdateReportsFailures(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{failUpdates: map[string]bool{"issue-2": true}}
+	api.issuesPage = linear.IssuePage{Nodes: []linear.IssueSummary{
+		{ID: "issue-1", Identifier: "ENG-1"},
+		{ID: "issue-2", Identifier: "ENG-2"},
+		{ID: "issue-3", Identifier: "ENG-3"},
+	}}
+	deps, out, errOut := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"--yes", "--json", "issue", "bulk-update", "--where", "team=ENG", "--where", "priority=low", "--priority", "high"})
+	if code != 1 {
+		t.Fatalf("expected exit 1, got %d (stderr: %s)", code, errOut.String())
+	}
+	if api.issuesFilter == nil || api.issuesFilter.TeamID != "team-ENG" || len(api.issuesFilter.Priorities) != 1 {
+		t.Fatalf("unexpected filter %+v", api.issuesFilter)
+	}
+	if got := strings.Join(updatedIDs(api), ","); got != "issue-1,issue-3" {
+		t.Fatalf("unexpected updates %s", got)
+	}
+	if !strings.Contains(out.String(), `"error": "boom"`) {
+		t.Fatalf("expected failure in report, got %s", out.String())
+	}
+	if !strings.Contains(errOut.String(), "1 of 3 issues failed to update") {
+		t.Fatalf("unexpected stderr %q", errOut.String())
+	}
+}
+
+func TestIssueBulkUpdateRejectsUnknownWhereKey(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	deps, _, _ := newTestDeps(&fakeAPI{})
+	if code := ExecuteWith(deps, []string{"--yes", "issue", "bulk-update", "--where", "colour=red", "--priority", "high"}); code != 2 {
+		t.Fatalf("expected exit 2, got %d", code)
+	}
+}
+
+func TestIssueListNDJSON(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{issuesPage: linear.IssuePage{Nodes: []linear.IssueSummary{
+		{ID: "issue-1", Identifier: "ENG-1"},
+		{ID: "issue-2", Identifier: "ENG-2"},
+	}}}
+	deps, out, errOut := newTestDeps(api)
+
+	if code := ExecuteWith(deps, []string{"issue", "list", "--format", "ndjson"}); code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], `{"id":"issue-1"`) {
+		t.Fatalf("unexpected NDJSON output %q", out.String())
+	}
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+type IssueChildrenCmd struct {
+	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
+	Depth   int    `help:"Maximum depth (0 for no limit)" default:"0"`
+	Limit   int    `help:"Maximum sub-issues per issue" default:"50"`
+}
+
+type issueTreeNode struct {
+	linear.IssueSummary
+	Children []issueTreeNode `json:"children"`
+}
+
+func (c *IssueChildrenCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	if c.Depth < 0 {
+		return exitError(2, fmt.Errorf("invalid depth %d", c.Depth))
+	}
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	issueID, err := client.ResolveIssueID(ctx, c.IssueID)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+
+	seen := map[string]struct{}{issueID: {}}
+	tree, err := c.children(ctx, client, issueID, 1, seen)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(tree)
+	}
+	if len(tree) == 0 {
+		_, _ = fmt.Fprintln(cmdCtx.deps.Out, "No sub-issues")
+		return nil
+	}
+	printIssueTree(cmdCtx.deps.Out, tree, 0)
+	return nil
+}
+
+func (c *IssueChildrenCmd) children(ctx context.Context, client linear.API, issueID string, depth int, seen map[string]struct{}) ([]issueTreeNode, error) {
+	children, err := client.IssueChildren(ctx, issueID, c.Limit)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]issueTreeNode, 0, len(children))
+	for _, child := range children {
+		if _, ok := seen[child.ID]; ok {
+			continue
+		}
+		seen[child.ID] = struct{}{}
+		node := issueTreeNode{IssueSummary: child, Children: []issueTreeNode{}}
+		if c.Depth == 0 || depth < c.Depth {
+			node.Children, err = c.children(ctx, client, child.ID, depth+1, seen)
+			if err != nil {
+				return nil, err
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+func printIssueTree(w io.Writer, nodes []issueTreeNode, level int) {
+	indent := strings.Repeat("  ", level)
+	for _, node := range nodes {
+		_, _ = fmt.Fprintf(w, "%s%s %s [%s]\n", indent, node.Identifier, node.Title, node.State)
+		printIssueTree(w, node.Children, level+1)
+	}
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func TestIssueChildrenPrintsTree(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{children: map[string][]linear.IssueSummary{
+		"ENG-10": {
+			{ID: "ENG-11", Identifier: "ENG-11", Title: "API", State: "Done"},
+			{ID: "ENG-12", Identifier: "ENG-12", Title: "UI", State: "Todo"},
+		},
+		"ENG-11": {
+			{ID: "ENG-13", Identifier: "ENG-13", Title: "Schema", State: "Done"},
+		},
+		"ENG-13": {
+			{ID: "ENG-14", Identifier: "ENG-14", Title: "Too deep", State: "Todo"},
+		},
+	}}
+	deps, out, errOut := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"issue", "children", "ENG-10", "--depth", "2"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	expected := "ENG-11 API [Done]\n  ENG-13 Schema [Done]\nENG-12 UI [Todo]\n"
+	if out.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, out.String())
+	}
+}
+
+func TestIssueUpdateParentFlags(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, _, errOut := newTestDeps(api)
+
+	if code := ExecuteWith(deps, []string{"issue", "update", "ENG-2", "--parent", "ENG-1"}); code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if code := ExecuteWith(deps, []string{"issue", "update", "ENG-2", "--no-parent"}); code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if code := ExecuteWith(deps, []string{"issue", "update", "ENG-2", "--parent", "ENG-2"}); code != 2 {
+		t.Fatalf("expected exit 2 for self parent, got %d", code)
+	}
+	if len(api.updates) != 2 {
+		t.Fatalf("expected 2 updates, got %d", len(api.updates))
+	}
+	if vars := api.updates[0].Input.Variables(); vars["parentId"] != "ENG-1" {
+		t.Fatalf("expected parentId ENG-1, got %v", vars["parentId"])
+	}
+	if vars := api.updates[1].Input.Variables(); vars["parentId"] != nil {
+		t.Fatalf("expected null parentId, got %v", vars["parentId"])
+	} else if _, ok := vars["parentId"]; !ok {
+		t.Fatalf("expected parentId to be sent")
+	}
+}
@@ -0,0 +1,275 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+const commentPageSize = 100
+
+type CommentCmd struct {
+	List      CommentListCmd      `cmd:"" help:"List comments on an issue"`
+	Edit      CommentEditCmd      `cmd:"" help:"Edit a comment"`
+	Delete    CommentDeleteCmd    `cmd:"" help:"Delete a comment"`
+	Reply     CommentReplyCmd     `cmd:"" help:"Reply to a comment thread"`
+	Resolve   CommentResolveCmd   `cmd:"" help:"Resolve a comment thread"`
+	Unresolve CommentUnresolveCmd `cmd:"" help:"Reopen a resolved comment thread"`
+}
+
+type CommentListCmd struct {
+	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
+	Limit   int    `help:"Maximum number of comments per page" default:"50"`
+	After   string `help:"Pagination cursor"`
+	All     bool   `help:"Fetch every page"`
+}
+
+type CommentEditCmd struct {
+	CommentID string `arg:"" name:"comment-id" help:"Comment ID"`
+	Body      string `help:"New comment body or '-' for stdin"`
+	Edit      bool   `help:"Edit the comment in $VISUAL/$EDITOR"`
+}
+
+type CommentDeleteCmd struct {
+	CommentID string `arg:"" name:"comment-id" help:"Comment ID"`
+}
+
+type CommentReplyCmd struct {
+	CommentID string `arg:"" name:"comment-id" help:"Comment ID to reply to"`
+	Body      string `help:"Reply body or '-' for stdin"`
+	Edit      bool   `help:"Write the reply in $VISUAL/$EDITOR"`
+}
+
+type CommentResolveCmd struct {
+	CommentID string `arg:"" name:"comment-id" help:"Comment ID of the thread"`
+}
+
+type CommentUnresolveCmd struct {
+	CommentID string `arg:"" name:"comment-id" help:"Comment ID of the thread"`
+}
+
+func (c *CommentListCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	if c.Limit <= 0 {
+		return exitError(2, errors.New("--limit must be greater than zero"))
+	}
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+
+	limit := c.Limit
+	if c.All {
+		limit = commentPageSize
+	}
+	result := linear.CommentPage{Nodes: []linear.Comment{}}
+	after := c.After
+	for {
+		page, err := client.Comments(ctx, c.IssueID, limit, after)
+		if err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		result.Nodes = append(result.Nodes, page.Nodes...)
+		result.PageInfo = page.PageInfo
+		if !c.All || !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			break
+		}
+		after = page.PageInfo.EndCursor
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(result)
+	}
+	rows := make([][]string, 0, len(result.Nodes))
+	for _, comment := range threadComments(result.Nodes) {
+		body := commentSummary(comment)
+		if comment.ParentID != "" {
+			body = "  > " + body
+		}
+		resolved := ""
+		if comment.ResolvedAt != "" {
+			resolved = "resolved"
+		}
+		rows = append(rows, []string{comment.ID, commentAuthor(comment), comment.CreatedAt, resolved, body})
+	}
+	if err := out.PrintTable([]string{"ID", "Author", "Created", "Thread", "Body"}, rows); err != nil {
+		return err
+	}
+	if result.PageInfo.HasNextPage && !cmdCtx.global.Quiet {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Err, "More comments available; pass --after %s or --all\n", result.PageInfo.EndCursor)
+	}
+	return nil
+}
+
+func (c *CommentEditCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	if c.Edit && c.Body == "-" {
+		return exitError(2, errors.New("--edit cannot read the body from stdin; pass it as --body text"))
+	}
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	text, err := readOptionalBody(c.Body, cmdCtx.deps.In)
+	if err != nil {
+		return exitError(1, err)
+	}
+	if c.Edit {
+		if text == "" {
+			comment, err := client.Comment(ctx, c.CommentID)
+			if err != nil {
+				return exitError(mapErrorToExitCode(err), err)
+			}
+			text = comment.Body
+		}
+		text, err = editText(cmdCtx, "comment-*.md", text)
+		if err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(text) == "" {
+		return exitError(2, errors.New("comment body is required; pass --body or --edit"))
+	}
+	if err := client.CommentUpdate(ctx, c.CommentID, text); err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	return printCommentAction(cmdCtx, c.CommentID, "updated", "Comment updated")
+}
+
+func (c *CommentDeleteCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	comment, err := client.Comment(ctx, c.CommentID)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	question := fmt.Sprintf("Delete comment %s by %s on %s?", comment.ID, commentAuthor(comment), comment.Issue)
+	if err := confirmAction(cmdCtx, question); err != nil {
+		return err
+	}
+	if err := client.CommentDelete(ctx, comment.ID); err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	return printCommentAction(cmdCtx, comment.ID, "deleted", "Comment deleted")
+}
+
+func (c *CommentReplyCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	if c.Edit && c.Body == "-" {
+		return exitError(2, errors.New("--edit cannot read the body from stdin; pass it as --body text"))
+	}
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	parent, err := client.Comment(ctx, c.CommentID)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	text, err := readOptionalBody(c.Body, cmdCtx.deps.In)
+	if err != nil {
+		return exitError(1, err)
+	}
+	if c.Edit {
+		text, err = editText(cmdCtx, "comment-*.md", text)
+		if err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(text) == "" {
+		return exitError(2, errors.New("reply body is required"))
+	}
+
+	// Linear threads are one level deep: replying to a reply adds to its thread.
+	parentID := parent.ID
+	if parent.ParentID != "" {
+		parentID = parent.ParentID
+	}
+	commentID, err := client.CommentReply(ctx, parent.IssueID, parentID, text)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(map[string]string{"id": commentID, "parent_id": parentID})
+	}
+	_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Reply added: %s\n", commentID)
+	return nil
+}
+
+func (c *CommentResolveCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	if err := client.CommentResolve(ctx, c.CommentID); err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	return printCommentAction(cmdCtx, c.CommentID, "resolved", "Thread resolved")
+}
+
+func (c *CommentUnresolveCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	if err := client.CommentUnresolve(ctx, c.CommentID); err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	return printCommentAction(cmdCtx, c.CommentID, "unresolved", "Thread reopened")
+}
+
+func printCommentAction(cmdCtx *commandContext, commentID, action, message string) error {
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(map[string]string{"id": commentID, "action": action})
+	}
+	_, _ = fmt.Fprintf(cmdCtx.deps.Out, "%s: %s\n", message, commentID)
+	return nil
+}
+
+// threadComments orders comments so each reply follows its thread's root.
+// Replies whose root is not in the list keep their position.
+func threadComments(comments []linear.Comment) []linear.Comment {
+	roots := map[string]bool{}
+	for _, comment := range comments {
+		if comment.ParentID == "" {
+			roots[comment.ID] = true
+		}
+	}
+	replies := map[string][]linear.Comment{}
+	for _, comment := range comments {
+		if comment.ParentID != "" && roots[comment.ParentID] {
+			replies[comment.ParentID] = append(replies[comment.ParentID], comment)
+		}
+	}
+
+	ordered := make([]linear.Comment, 0, len(comments))
+	for _, comment := range comments {
+		if comment.ParentID != "" && roots[comment.ParentID] {
+			continue
+		}
+		ordered = append(ordered, comment)
+		ordered = append(ordered, replies[comment.ID]...)
+	}
+	return ordered
+}
+
+func commentAuthor(comment linear.Comment) string {
+	if comment.UserName != "" {
+		return comment.UserName
+	}
+	return comment.UserEmail
+}
+
+func commentSummary(comment linear.Comment) string {
+	body, _, _ := strings.Cut(strings.TrimSpace(comment.Body), "\n")
+	runes := []rune(body)
+	if len(runes) > 60 {
+		body = string(runes[:59]) + "…"
+	}
+	return body
+}
@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func newCommentTestAPI() *fakeAPI {
+	api := newFakeAPI()
+	api.commentsByID = map[string]linear.Comment{
+		"c1": {ID: "c1", Body: "Root", UserName: "Ada", IssueID: "issue-1", Issue: "ENG-1"},
+		"c2": {ID: "c2", Body: "Reply", UserName: "Grace", ParentID: "c1", IssueID: "issue-1", Issue: "ENG-1"},
+	}
+	return api
+}
+
+func TestComment(t *testing.T) {
+	noChanges := func(t *testing.T, api *fakeAPI, _ *cliTest) {
+		if len(api.commentUpdates) != 0 || len(api.replies) != 0 {
+			t.Fatal("expected no changes")
+		}
+	}
+	tests := []struct {
+		name  string
+		pages []linear.CommentPage
+		in    string
+		// before runs commands that must succeed ahead of args.
+		before [][]string
+		args   []string
+		code   int
+		check  func(t *testing.T, api *fakeAPI, c *cliTest)
+	}{
+		{
+			name: "list fetches all pages and threads",
+			pages: []linear.CommentPage{
+				{
+					Nodes: []linear.Comment{
+						{ID: "c1", Body: "Root\nsecond line", UserName: "Ada", ResolvedAt: "2026-01-03T10:00:00Z"},
+						{ID: "c3", Body: "Another", UserName: "Ada"},
+					},
+					PageInfo: linear.PageInfo{HasNextPage: true, EndCursor: "cursor-1"},
+				},
+				{Nodes: []linear.Comment{{ID: "c2", Body: "Reply", UserName: "Grace", ParentID: "c1"}}},
+			},
+			args: []string{"comment", "list", "ENG-1", "--all"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if strings.Join(api.commentAfters, ",") != ",cursor-1" {
+					t.Fatalf("expected two pages, got cursors %v", api.commentAfters)
+				}
+				lines := strings.Split(strings.TrimSpace(c.out.String()), "\n")
+				if len(lines) != 4 {
+					t.Fatalf("expected header and 3 rows, got:\n%s", c.out.String())
+				}
+				if strings.Join(strings.Fields(lines[1]), " ") != "c1 Ada resolved Root" {
+					t.Fatalf("unexpected root row %q", lines[1])
+				}
+				if !strings.HasPrefix(lines[2], "c2 ") || !strings.HasSuffix(lines[2], "  > Reply") {
+					t.Fatalf("expected reply under its root, got %q", lines[2])
+				}
+				if c.errOut.Len() != 0 {
+					t.Fatalf("expected no pagination hint, got %q", c.errOut.String())
+				}
+			},
+		},
+		{
+			name: "list hints at next page",
+			pages: []linear.CommentPage{
+				{Nodes: []linear.Comment{{ID: "c1", Body: "Root"}}, PageInfo: linear.PageInfo{HasNextPage: true, EndCursor: "cursor-1"}},
+			},
+			args: []string{"comment", "list", "ENG-1", "--limit", "1"},
+			check: func(t *testing.T, _ *fakeAPI, c *cliTest) {
+				if !strings.Contains(c.errOut.String(), "--after cursor-1") {
+					t.Fatalf("expected pagination hint, got %q", c.errOut.String())
+				}
+			},
+		},
+		{
+			name: "reply to reply uses thread root",
+			args: []string{"comment", "reply", "c2", "--body", "Thanks"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if len(api.replies) != 1 || api.replies[0] != (fakeReply{IssueID: "issue-1", ParentID: "c1", Body: "Thanks"}) {
+					t.Fatalf("unexpected replies %+v", api.replies)
+				}
+				if c.out.String() != "Reply added: reply-1\n" {
+					t.Fatalf("unexpected output %q", c.out.String())
+				}
+			},
+		},
+		{name: "delete requires confirmation", args: []string{"comment", "delete", "c1"}, code: 2},
+		{
+			name: "delete with yes",
+			args: []string{"comment", "delete", "c1", "--yes"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.deletedComments) != 1 || api.deletedComments[0] != "c1" {
+					t.Fatalf("unexpected deletes %v", api.deletedComments)
+				}
+			},
+		},
+		{name: "delete missing comment", args: []string{"comment", "delete", "missing", "--yes"}, code: 4},
+		{
+			name:   "undo resolve before edit",
+			before: [][]string{{"comment", "edit", "c1", "--body", "Edited"}, {"comment", "resolve", "c1"}},
+			args:   []string{"undo"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.unresolved) != 1 || api.unresolved[0] != "c1" {
+					t.Fatalf("expected resolve to be undone, got %v", api.unresolved)
+				}
+				if api.commentUpdates["c1"] != "Edited" {
+					t.Fatalf("expected the edit to be kept, got %v", api.commentUpdates)
+				}
+			},
+		},
+		{
+			name:   "undo edit",
+			before: [][]string{{"comment", "edit", "c1", "--body", "Edited"}, {"comment", "resolve", "c1"}, {"undo"}},
+			args:   []string{"undo"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if api.commentUpdates["c1"] != "Root" {
+					t.Fatalf("expected original body to be restored, got %v", api.commentUpdates)
+				}
+			},
+		},
+		{name: "edit rejects stdin body with edit", in: "From stdin", args: []string{"comment", "edit", "c1", "--body", "-", "--edit"}, code: 2, check: noChanges},
+		{name: "reply rejects stdin body with edit", in: "From stdin", args: []string{"comment", "reply", "c1", "--body", "-", "--edit"}, code: 2, check: noChanges},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newCommentTestAPI()
+			api.commentPages = tt.pages
+			c := newCLITest(t, api).withJournal()
+			c.deps.Editor = func(string) error {
+				t.Fatal("editor should not run")
+				return nil
+			}
+			for _, args := range tt.before {
+				c.run(0, args...)
+			}
+			c.deps.In = strings.NewReader(tt.in)
+			c.run(tt.code, tt.args...)
+			if tt.check != nil {
+				tt.check(t, api, c)
+			}
+		})
+	}
+}
@@ -9,8 +9,11 @@ import (
 )
 
 type commandContext struct {
-	deps   Dependencies
-	global *GlobalOptions
+	deps      Dependencies
+	global    *GlobalOptions
+	mutations *linear.MutationLog
+	run       string
+	command   string
 }
 
 func (c *commandContext) resolveAPIKey() (string, string, error) {
@@ -40,5 +43,12 @@ func (c *commandContext) apiClient() (linear.API, error) {
 	if c.deps.NewClient == nil {
 		return nil, fmt.Errorf("no API client configured")
 	}
-	return c.deps.NewClient(key, c.global.Timeout), nil
+	client := c.deps.NewClient(key, c.global.Timeout)
+	if c.mutations != nil {
+		return linear.DryRun(client, c.mutations), nil
+	}
+	if c.deps.Journal != nil {
+		return c.journaled(client), nil
+	}
+	return client, nil
 }
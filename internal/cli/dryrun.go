@@ -0,0 +1,30 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+func printDryRun(deps Dependencies, cmdCtx *commandContext, buffered *bytes.Buffer) error {
+	mutations := cmdCtx.mutations.Mutations()
+	if len(mutations) == 0 {
+		_, err := deps.Out.Write(buffered.Bytes())
+		return err
+	}
+	out := output{Out: deps.Out, JSON: cmdCtx.global.JSON}
+	if out.JSON {
+		return out.PrintJSON(mutations)
+	}
+	for i, m := range mutations {
+		vars, err := json.MarshalIndent(m.Variables, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(deps.Out, "# mutation %d of %d (not sent)\n%s\nvariables: %s\n", i+1, len(mutations), m.Query, vars)
+		if i < len(mutations)-1 {
+			_, _ = fmt.Fprintln(deps.Out)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func TestDryRunPrintsMutationsInsteadOfSending(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, out, errOut := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"--dry-run", "issue", "create", "--team", "ENG", "--title", "Spike", "--state", "Todo", "--blocks", "ENG-2"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.creates) != 0 || len(api.createdRelations) != 0 {
+		t.Fatal("expected no mutations to reach the API")
+	}
+	text := out.String()
+	for _, want := range []string{
+		"# mutation 1 of 2 (not sent)",
+		"issueCreate(input: $input)",
+		`"stateId": "team-ENG/state-Todo"`,
+		"# mutation 2 of 2 (not sent)",
+		`"relatedIssueId": "ENG-2"`,
+	} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("expected %q in output:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "ENG-100") {
+		t.Fatalf("expected command output to be replaced:\n%s", text)
+	}
+}
+
+func TestDryRunJSON(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, out, errOut := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"--dry-run", "--json", "issue", "comment", "ENG-1", "--body", "hello"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	var mutations []linear.Mutation
+	if err := json.Unmarshal(out.Bytes(), &mutations); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, out.String())
+	}
+	if len(mutations) != 1 || !strings.Contains(mutations[0].Query, "commentCreate") {
+		t.Fatalf("unexpected mutations %+v", mutations)
+	}
+	if len(api.comments) != 0 {
+		t.Fatalf("expected no comments, got %v", api.comments)
+	}
+}
+
+func TestDryRunSkipsConfirmationAndKeepsReadOutput(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := newFakeAPI(linear.IssueDetail{ID: "issue-1", Identifier: "ENG-1", Title: "Old bug"})
+	deps, out, errOut := newTestDeps(api)
+
+	if code := ExecuteWith(deps, []string{"--dry-run", "issue", "delete", "ENG-1"}); code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.deleted) != 0 || !strings.Contains(out.String(), "issueDelete(id: $id") {
+		t.Fatalf("unexpected result: deleted=%v output=%s", api.deleted, out.String())
+	}
+
+	out.Reset()
+	api.issuesPage = linear.IssuePage{Nodes: []linear.IssueSummary{{Identifier: "ENG-1", Title: "Old bug"}}}
+	if code := ExecuteWith(deps, []string{"--dry-run", "issue", "list"}); code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if !strings.Contains(out.String(), "Old bug") {
+		t.Fatalf("expected list output, got %q", out.String())
+	}
+}
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const frontMatterDelimiter = "---"
+
+var errEditAborted = errors.New("edit aborted: buffer was empty or unchanged; nothing was changed")
+
+type issueDraft struct {
+	Title    string
+	State    string
+	Assignee string
+	Labels   []string
+	Priority string
+	Body     string
+}
+
+func (d issueDraft) render() string {
+	var b strings.Builder
+	b.WriteString(frontMatterDelimiter + "\n")
+	fmt.Fprintf(&b, "title: %s\n", d.Title)
+	fmt.Fprintf(&b, "state: %s\n", d.State)
+	fmt.Fprintf(&b, "assignee: %s\n", d.Assignee)
+	fmt.Fprintf(&b, "labels: %s\n", strings.Join(d.Labels, ", "))
+	fmt.Fprintf(&b, "priority: %s\n", d.Priority)
+	b.WriteString(frontMatterDelimiter + "\n\n")
+	if d.Body != "" {
+		b.WriteString(d.Body + "\n")
+	}
+	return b.String()
+}
+
+func parseIssueDraft(text string) (issueDraft, error) {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	lines := strings.Split(text, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != frontMatterDelimiter {
+		return issueDraft{}, errors.New("missing front matter header")
+	}
+
+	draft := issueDraft{}
+	for i := 1; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == frontMatterDelimiter {
+			draft.Body = strings.TrimSpace(strings.Join(lines[i+1:], "\n"))
+			return draft, nil
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return issueDraft{}, fmt.Errorf("invalid front matter line %q", line)
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "title":
+			draft.Title = value
+		case "state":
+			draft.State = value
+		case "assignee":
+			draft.Assignee = value
+		case "labels":
+			draft.Labels = splitComma(value)
+		case "priority":
+			draft.Priority = value
+		default:
+			return issueDraft{}, fmt.Errorf("unknown front matter field %q", strings.TrimSpace(key))
+		}
+	}
+	return issueDraft{}, errors.New("front matter header is not closed")
+}
+
+// editIssueDraft opens draft in the editor. Saving a buffer that matches
+// original, or an empty one, aborts.
+func editIssueDraft(cmdCtx *commandContext, draft, original issueDraft) (issueDraft, error) {
+	text, err := editTextFrom(cmdCtx, "issue-*.md", draft.render(), original.render())
+	if err != nil {
+		return issueDraft{}, err
+	}
+	edited, err := parseIssueDraft(text)
+	if err != nil {
+		return issueDraft{}, exitError(2, err)
+	}
+	return edited, nil
+}
+
+func editText(cmdCtx *commandContext, pattern, initial string) (string, error) {
+	return editTextFrom(cmdCtx, pattern, initial, initial)
+}
+
+// editTextFrom edits initial and aborts if the result is empty or equal to
+// original. An abort is not a failure: it exits 0 without changing anything.
+func editTextFrom(cmdCtx *commandContext, pattern, initial, original string) (string, error) {
+	if cmdCtx.global.NoInput {
+		return "", exitError(2, errors.New("--edit requires interactive input"))
+	}
+	if cmdCtx.deps.Editor == nil {
+		return "", exitError(1, errors.New("no editor configured"))
+	}
+
+	file, err := os.CreateTemp("", "linear-"+pattern)
+	if err != nil {
+		return "", exitError(1, fmt.Errorf("create temp file: %w", err))
+	}
+	path := file.Name()
+	defer os.Remove(path)
+
+	if _, err := file.WriteString(initial); err != nil {
+		_ = file.Close()
+		return "", exitError(1, fmt.Errorf("write temp file: %w", err))
+	}
+	if err := file.Close(); err != nil {
+		return "", exitError(1, fmt.Errorf("close temp file: %w", err))
+	}
+
+	if err := cmdCtx.deps.Editor(path); err != nil {
+		return "", exitError(1, err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", exitError(1, fmt.Errorf("read temp file: %w", err))
+	}
+	text := string(data)
+	if strings.TrimSpace(text) == "" || text == original {
+		return "", exitError(0, errEditAborted)
+	}
+	return text, nil
+}
+
+func runEditor(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+	parts := strings.Fields(editor)
+	cmd := exec.Command(parts[0], append(parts[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("run editor %s: %w", parts[0], err)
+	}
+	return nil
+}
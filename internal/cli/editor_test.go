@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func TestIssueDraftRoundTrip(t *testing.T) {
+	draft := issueDraft{
+		Title:    "Fix login",
+		State:    "In Progress",
+		Assignee: "me",
+		Labels:   []string{"bug", "ui"},
+		Priority: "High",
+		Body:     "Steps:\n\n1. Open the app",
+	}
+	parsed, err := parseIssueDraft(draft.render())
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if parsed.Title != draft.Title || parsed.State != draft.State || parsed.Assignee != draft.Assignee || parsed.Priority != draft.Priority {
+		t.Fatalf("unexpected draft: %+v", parsed)
+	}
+	if !slices.Equal(parsed.Labels, draft.Labels) {
+		t.Fatalf("unexpected labels: %v", parsed.Labels)
+	}
+	if parsed.Body != draft.Body {
+		t.Fatalf("unexpected body: %q", parsed.Body)
+	}
+}
+
+func TestParseIssueDraftErrors(t *testing.T) {
+	cases := map[string]string{
+		"missing header": "just a body",
+		"unclosed":       "---\ntitle: x\n",
+		"unknown field":  "---\nowner: me\n---\n",
+	}
+	for name, text := range cases {
+		if _, err := parseIssueDraft(text); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
+
+func TestIssueUpdateEditSendsChangedFields(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{issues: map[string]linear.IssueDetail{
+		"ENG-1": {
+			ID:          "issue-1",
+			Identifier:  "ENG-1",
+			Title:       "Old title",
+			State:       "Todo",
+			TeamID:      "team-1",
+			Assignee:    "Ada",
+			Labels:      []string{},
+			Priority:    linear.PriorityLow,
+			Description: "Old body",
+		},
+	}}
+	deps, _, errOut := newTestDeps(api)
+	deps.Editor = func(path string) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		text := strings.Replace(string(data), "title: Old title", "title: New title", 1)
+		text = strings.Replace(text, "Old body", "New body", 1)
+		return os.WriteFile(path, []byte(text), 0o600)
+	}
+
+	code := ExecuteWith(deps, []string{"issue", "update", "ENG-1", "--edit"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	vars := api.updates[0].Input.Variables()
+	if vars["title"] != "New title" || vars["description"] != "New body" {
+		t.Fatalf("unexpected vars: %v", vars)
+	}
+	for _, key := range []string{"stateId", "assigneeId", "labelIds", "priority"} {
+		if _, ok := vars[key]; ok {
+			t.Fatalf("expected %s to be left unchanged, got %v", key, vars[key])
+		}
+	}
+}
+
+func TestIssueCommentEditAbortsWhenUnchanged(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, _, errOut := newTestDeps(api)
+	deps.Editor = func(string) error { return nil }
+
+	code := ExecuteWith(deps, []string{"issue", "comment", "ENG-1", "--edit"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d", code)
+	}
+	if !strings.Contains(errOut.String(), "edit aborted") {
+		t.Fatalf("expected abort message, got %q", errOut.String())
+	}
+	if len(api.comments) != 0 {
+		t.Fatalf("expected no comment, got %v", api.comments)
+	}
+
+	deps.In = strings.NewReader("From stdin")
+	if code := ExecuteWith(deps, []string{"issue", "comment", "ENG-1", "--body", "-", "--edit"}); code != 2 {
+		t.Fatalf("expected exit 2 for --body - with --edit, got %d", code)
+	}
+}
+
+func newEditTestAPI() *fakeAPI {
+	return &fakeAPI{issues: map[string]linear.IssueDetail{
+		"ENG-1": {
+			ID:          "issue-1",
+			Identifier:  "ENG-1",
+			Title:       "Old title",
+			State:       "Todo",
+			TeamID:      "team-1",
+			Labels:      []string{},
+			Priority:    linear.PriorityLow,
+			Description: "Old body",
+		},
+	}}
+}
+
+func TestIssueUpdateEditKeepsFlagValuesWhenSavedUnchanged(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := newEditTestAPI()
+	deps, _, errOut := newTestDeps(api)
+	deps.Editor = func(string) error { return nil }
+
+	code := ExecuteWith(deps, []string{"issue", "update", "ENG-1", "--title", "New title", "--description", "New body", "--edit"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.updates) != 1 {
+		t.Fatalf("expected one update, got %d", len(api.updates))
+	}
+	vars := api.updates[0].Input.Variables()
+	if vars["title"] != "New title" || vars["description"] != "New body" || len(vars) != 2 {
+		t.Fatalf("unexpected vars: %v", vars)
+	}
+}
+
+func TestIssueUpdateEditAbortsWhenIssueUnchanged(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := newEditTestAPI()
+	deps, _, errOut := newTestDeps(api)
+	deps.Editor = func(string) error { return nil }
+
+	if code := ExecuteWith(deps, []string{"issue", "update", "ENG-1", "--edit"}); code != 0 {
+		t.Fatalf("expected exit 0, got %d", code)
+	}
+	if len(api.updates) != 0 || !strings.Contains(errOut.String(), "nothing was changed") {
+		t.Fatalf("expected a clean abort, got %d updates (stderr: %q)", len(api.updates), errOut.String())
+	}
+
+	if code := ExecuteWith(deps, []string{"issue", "update", "ENG-1", "--description", "-", "--edit"}); code != 2 {
+		t.Fatalf("expected exit 2 for --description - with --edit, got %d", code)
+	}
+}
+
+func TestIssueCreateEditKeepsFlagValuesWhenSavedUnchanged(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, _, errOut := newTestDeps(api)
+	deps.Editor = func(string) error { return nil }
+
+	code := ExecuteWith(deps, []string{"issue", "create", "--team", "ENG", "--title", "Fix login", "--edit"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.creates) != 1 || api.creates[0].Title != "Fix login" {
+		t.Fatalf("expected the issue to be created, got %+v", api.creates)
+	}
+
+	if code := ExecuteWith(deps, []string{"issue", "create", "--team", "ENG", "--edit"}); code != 0 {
+		t.Fatalf("expected exit 0, got %d", code)
+	}
+	if len(api.creates) != 1 || !strings.Contains(errOut.String(), "edit aborted") {
+		t.Fatalf("expected an empty draft to abort, got %d creates (stderr: %q)", len(api.creates), errOut.String())
+	}
+
+	deps.In = strings.NewReader("From stdin")
+	if code := ExecuteWith(deps, []string{"issue", "create", "--team", "ENG", "--title", "Fix login", "--description", "-", "--edit"}); code != 2 {
+		t.Fatalf("expected exit 2 for --description - with --edit, got %d", code)
+	}
+}
@@ -0,0 +1,467 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/duailibe/linear-cli/internal/journal"
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+// fakeAPI is the one API stub shared by the command tests. Fixture fields are
+// set up front; recorder fields capture calls and are written under mu, since
+// bulk updates call the API from several goroutines.
+type fakeAPI struct {
+	linear.API
+
+	mu sync.Mutex
+
+	// Fixtures.
+	issues map[string]linear.IssueDetail
+	// strictIssueIDs makes ResolveIssueID fail for issues not in issues,
+	// like the real API, instead of passing the value through.
+	strictIssueIDs bool
+	issuesPage     linear.IssuePage
+	children       map[string][]linear.IssueSummary
+	commentsByID   map[string]linear.Comment
+	commentPages   []linear.CommentPage
+	issueComments  []linear.Comment
+	relations      linear.IssueRelationSet
+	// relationsByIssue, when set, answers IssueRelations per issue instead
+	// of returning relations for every issue.
+	relationsByIssue map[string]linear.IssueRelationSet
+	historyPages     []linear.IssueHistoryPage
+	attachments      []linear.Attachment
+	me               linear.User
+	teams            []linear.Team
+	users            []linear.User
+	states           map[string][]linear.WorkflowState
+	labels           map[string][]linear.Label
+	templates        []linear.IssueTemplate
+	failUpdates      map[string]bool
+
+	// Recorders.
+	issuesFilter     *linear.IssueFilter
+	updates          []fakeUpdate
+	creates          []linear.IssueCreateInput
+	labelTeamIDs     []string
+	comments         []string
+	commentAfters    []string
+	commentUpdates   map[string]string
+	replies          []fakeReply
+	resolved         []string
+	unresolved       []string
+	deletedComments  []string
+	createdRelations []linear.IssueRelation
+	deletedRelations []string
+	archived         []string
+	unarchived       []string
+	deleted          []string
+	subscribed       []string
+	unsubscribed     []string
+	reactions        []fakeReaction
+	removedReactions []string
+	historyAfters    []string
+	createdLinks     []linear.AttachmentCreateInput
+	deletedLinks     []string
+	uploads          []string
+}
+
+// errBoom is returned for issues listed in failUpdates.
+var errBoom = errors.New("boom")
+
+type fakeReply struct {
+	IssueID  string
+	ParentID string
+	Body     string
+}
+
+type fakeUpdate struct {
+	IssueID string
+	Input   linear.IssueUpdateInput
+}
+
+type fakeReaction struct {
+	IssueID   string
+	CommentID string
+	Emoji     string
+}
+
+// newFakeAPI returns a fakeAPI that knows issues by their identifier.
+func newFakeAPI(issues ...linear.IssueDetail) *fakeAPI {
+	api := &fakeAPI{issues: map[string]linear.IssueDetail{}}
+	for _, issue := range issues {
+		api.issues[issue.Identifier] = issue
+	}
+	return api
+}
+
+func (f *fakeAPI) ResolveIssueID(_ context.Context, value string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if issue, ok := f.issues[value]; ok {
+		return issue.ID, nil
+	}
+	if f.strictIssueIDs {
+		return "", linear.ErrNotFound
+	}
+	return value, nil
+}
+
+func (f *fakeAPI) Issue(_ context.Context, value string) (linear.IssueDetail, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if issue, ok := f.issues[value]; ok {
+		return issue, nil
+	}
+	for _, issue := range f.issues {
+		if issue.ID == value {
+			return issue, nil
+		}
+	}
+	return linear.IssueDetail{}, linear.ErrNotFound
+}
+
+func (f *fakeAPI) Issues(_ context.Context, filter linear.IssueFilter, _ int, _ string) (linear.IssuePage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.issuesFilter = &filter
+	return f.issuesPage, nil
+}
+
+func (f *fakeAPI) IssueChildren(_ context.Context, issueID string, _ int) ([]linear.IssueSummary, error) {
+	return f.children[issueID], nil
+}
+
+func (f *fakeAPI) IssueCreate(_ context.Context, input linear.IssueCreateInput) (linear.IssueSummary, error) {
+	if err := input.Validate(); err != nil {
+		return linear.IssueSummary{}, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.creates = append(f.creates, input)
+	return linear.IssueSummary{ID: "issue-new", Identifier: "ENG-100", Title: input.Title}, nil
+}
+
+func (f *fakeAPI) IssueUpdate(_ context.Context, issueID string, input linear.IssueUpdateInput) (linear.IssueSummary, error) {
+	if err := input.Validate(); err != nil {
+		return linear.IssueSummary{}, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failUpdates[issueID] {
+		return linear.IssueSummary{}, errBoom
+	}
+	f.updates = append(f.updates, fakeUpdate{IssueID: issueID, Input: input})
+	return linear.IssueSummary{ID: issueID, Identifier: issueID}, nil
+}
+
+func (f *fakeAPI) IssueArchive(_ context.Context, issueID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.archived = append(f.archived, issueID)
+	return nil
+}
+
+func (f *fakeAPI) IssueUnarchive(_ context.Context, issueID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unarchived = append(f.unarchived, issueID)
+	return nil
+}
+
+func (f *fakeAPI) IssueDelete(_ context.Context, issueID string, permanent bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if permanent {
+		issueID += " (permanent)"
+	}
+	f.deleted = append(f.deleted, issueID)
+	return nil
+}
+
+func (f *fakeAPI) IssueSubscribe(_ context.Context, issueID, userID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.subscribed = append(f.subscribed, issueID+"/"+userID)
+	return nil
+}
+
+func (f *fakeAPI) IssueUnsubscribe(_ context.Context, issueID, userID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unsubscribed = append(f.unsubscribed, issueID+"/"+userID)
+	return nil
+}
+
+func (f *fakeAPI) IssueHistory(_ context.Context, _ string, _ int, after string) (linear.IssueHistoryPage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.historyAfters = append(f.historyAfters, after)
+	if len(f.historyPages) == 0 {
+		return linear.IssueHistoryPage{}, nil
+	}
+	page := f.historyPages[0]
+	f.historyPages = f.historyPages[1:]
+	return page, nil
+}
+
+func (f *fakeAPI) IssueComment(_ context.Context, _ string, body string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.comments = append(f.comments, body)
+	return "comment-1", nil
+}
+
+func (f *fakeAPI) IssueComments(context.Context, string, int) ([]linear.Comment, error) {
+	return f.issueComments, nil
+}
+
+func (f *fakeAPI) Comments(_ context.Context, _ string, _ int, after string) (linear.CommentPage, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.commentAfters = append(f.commentAfters, after)
+	if len(f.commentPages) == 0 {
+		return linear.CommentPage{}, nil
+	}
+	page := f.commentPages[0]
+	f.commentPages = f.commentPages[1:]
+	return page, nil
+}
+
+func (f *fakeAPI) Comment(_ context.Context, commentID string) (linear.Comment, error) {
+	if comment, ok := f.commentsByID[commentID]; ok {
+		return comment, nil
+	}
+	return linear.Comment{}, linear.ErrNotFound
+}
+
+func (f *fakeAPI) CommentUpdate(_ context.Context, commentID, body string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.commentUpdates == nil {
+		f.commentUpdates = map[string]string{}
+	}
+	f.commentUpdates[commentID] = body
+	return nil
+}
+
+func (f *fakeAPI) CommentReply(_ context.Context, issueID, parentID, body string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.replies = append(f.replies, fakeReply{IssueID: issueID, ParentID: parentID, Body: body})
+	return "reply-1", nil
+}
+
+func (f *fakeAPI) CommentResolve(_ context.Context, commentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.resolved = append(f.resolved, commentID)
+	return nil
+}
+
+func (f *fakeAPI) CommentUnresolve(_ context.Context, commentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unresolved = append(f.unresolved, commentID)
+	return nil
+}
+
+func (f *fakeAPI) CommentDelete(_ context.Context, commentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deletedComments = append(f.deletedComments, commentID)
+	return nil
+}
+
+func (f *fakeAPI) ReactionCreate(_ context.Context, issueID, commentID, emoji string) (linear.Reaction, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.reactions = append(f.reactions, fakeReaction{IssueID: issueID, CommentID: commentID, Emoji: emoji})
+	return linear.Reaction{ID: "reaction-new", Emoji: emoji}, nil
+}
+
+func (f *fakeAPI) ReactionDelete(_ context.Context, reactionID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removedReactions = append(f.removedReactions, reactionID)
+	return nil
+}
+
+func (f *fakeAPI) IssueRelations(_ context.Context, issueID string, _ int) (linear.IssueRelationSet, error) {
+	if f.relationsByIssue != nil {
+		return f.relationsByIssue[issueID], nil
+	}
+	return f.relations, nil
+}
+
+func (f *fakeAPI) IssueRelationCreate(_ context.Context, issueID, relatedIssueID, relationType string) (linear.IssueRelation, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	rel := linear.IssueRelation{ID: "rel-new", IssueID: issueID, RelatedIssueID: relatedIssueID, Type: relationType}
+	f.createdRelations = append(f.createdRelations, rel)
+	return rel, nil
+}
+
+func (f *fakeAPI) IssueRelationDelete(_ context.Context, relationID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deletedRelations = append(f.deletedRelations, relationID)
+	return nil
+}
+
+func (f *fakeAPI) IssueAttachments(context.Context, string, int) ([]linear.Attachment, error) {
+	return f.attachments, nil
+}
+
+func (f *fakeAPI) AttachmentCreate(_ context.Context, input linear.AttachmentCreateInput) (linear.Attachment, error) {
+	if err := input.Validate(); err != nil {
+		return linear.Attachment{}, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createdLinks = append(f.createdLinks, input)
+	return linear.Attachment{ID: "att-new", Title: input.Title, URL: input.URL}, nil
+}
+
+func (f *fakeAPI) AttachmentDelete(_ context.Context, attachmentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deletedLinks = append(f.deletedLinks, attachmentID)
+	return nil
+}
+
+func (f *fakeAPI) UploadFile(_ context.Context, filename, contentType string, size int64, body io.Reader) (string, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "", err
+	}
+	if int64(len(data)) != size {
+		return "", io.ErrShortBuffer
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.uploads = append(f.uploads, filename+" "+contentType)
+	return "https://uploads.linear.app/x/" + filename, nil
+}
+
+// Me returns me, or a user "user-me" when the test did not set one.
+func (f *fakeAPI) Me(context.Context) (linear.User, error) {
+	if f.me.ID == "" {
+		return linear.User{ID: "user-me", Name: "Me"}, nil
+	}
+	return f.me, nil
+}
+
+// ResolveUserID maps "me" to "user-me" and an email to "user-<local part>";
+// missing@example.com is not found.
+func (f *fakeAPI) ResolveUserID(_ context.Context, value string) (string, error) {
+	switch value {
+	case "me":
+		return "user-me", nil
+	case "missing@example.com":
+		return "", linear.ErrNotFound
+	}
+	return "user-" + strings.Split(value, "@")[0], nil
+}
+
+func (f *fakeAPI) ResolveTeamID(_ context.Context, keyOrID string) (string, error) {
+	return "team-" + keyOrID, nil
+}
+
+func (f *fakeAPI) ResolveStateID(_ context.Context, teamID, value string) (string, error) {
+	return teamID + "/state-" + value, nil
+}
+
+func (f *fakeAPI) ResolveLabelIDs(_ context.Context, teamID string, labels []string) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.labelTeamIDs = append(f.labelTeamIDs, teamID)
+	ids := make([]string, 0, len(labels))
+	for _, label := range labels {
+		ids = append(ids, "label-"+label)
+	}
+	return ids, nil
+}
+
+func (f *fakeAPI) Teams(context.Context) ([]linear.Team, error) {
+	return f.teams, nil
+}
+
+func (f *fakeAPI) Users(context.Context) ([]linear.User, error) {
+	return f.users, nil
+}
+
+func (f *fakeAPI) WorkflowStates(_ context.Context, teamID string) ([]linear.WorkflowState, error) {
+	return f.states[teamID], nil
+}
+
+func (f *fakeAPI) IssueLabels(_ context.Context, teamID string) ([]linear.Label, error) {
+	return f.labels[teamID], nil
+}
+
+func (f *fakeAPI) Projects(context.Context) ([]linear.Project, error) {
+	return nil, nil
+}
+
+func (f *fakeAPI) Cycles(context.Context, string, bool, int, string) (linear.CyclePage, error) {
+	return linear.CyclePage{}, nil
+}
+
+func (f *fakeAPI) IssueTemplates(context.Context) ([]linear.IssueTemplate, error) {
+	return f.templates, nil
+}
+
+func newTestDeps(api linear.API) (Dependencies, *bytes.Buffer, *bytes.Buffer) {
+	var out bytes.Buffer
+	var errOut bytes.Buffer
+	deps := Dependencies{
+		In:  bytes.NewBuffer(nil),
+		Out: &out,
+		Err: &errOut,
+		Now: time.Now,
+		NewClient: func(string, time.Duration) linear.API {
+			return api
+		},
+	}
+	return deps, &out, &errOut
+}
+
+// cliTest runs commands against an API stub and captures their output.
+type cliTest struct {
+	t      *testing.T
+	deps   Dependencies
+	out    *bytes.Buffer
+	errOut *bytes.Buffer
+}
+
+func newCLITest(t *testing.T, api linear.API) *cliTest {
+	t.Helper()
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	deps, out, errOut := newTestDeps(api)
+	return &cliTest{t: t, deps: deps, out: out, errOut: errOut}
+}
+
+// withJournal records mutations in a fresh journal so commands can be undone.
+func (c *cliTest) withJournal() *cliTest {
+	c.deps.Journal = journal.NewStore(filepath.Join(c.t.TempDir(), "journal.jsonl"))
+	return c
+}
+
+// run executes args and fails the test unless the command exits with want.
+// Output is reset first, so it always belongs to the latest run.
+func (c *cliTest) run(want int, args ...string) {
+	c.t.Helper()
+	c.out.Reset()
+	c.errOut.Reset()
+	if code := ExecuteWith(c.deps, args); code != want {
+		c.t.Fatalf("%s: expected exit %d, got %d (stderr: %s)", strings.Join(args, " "), want, code, c.errOut.String())
+	}
+}
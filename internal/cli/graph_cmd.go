@@ -0,0 +1,444 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+const graphScopeLimit = 500
+
+type IssueGraphCmd struct {
+	IssueID string `arg:"" optional:"" name:"issue-id" help:"Issue ID to start from"`
+	Depth   int    `help:"Maximum number of blocks hops to follow (0 for no limit)" default:"3"`
+	Format  string `help:"Graph format (dot or mermaid)" enum:"dot,mermaid" default:"dot"`
+	Team    string `help:"Team key or ID (to resolve --cycle)"`
+	Project string `help:"Start from every issue in this project (name or ID)"`
+	Cycle   string `help:"Start from every issue in this cycle (ID or 'current')"`
+}
+
+type graphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type issueGraph struct {
+	Nodes        []linear.IssueRef `json:"nodes"`
+	Edges        []graphEdge       `json:"edges"`
+	Cycles       [][]string        `json:"cycles"`
+	CriticalPath []string          `json:"critical_path"`
+
+	index map[string]int
+	edges map[graphEdge]struct{}
+}
+
+func newIssueGraph() *issueGraph {
+	return &issueGraph{
+		Nodes:        []linear.IssueRef{},
+		Edges:        []graphEdge{},
+		Cycles:       [][]string{},
+		CriticalPath: []string{},
+		index:        map[string]int{},
+		edges:        map[graphEdge]struct{}{},
+	}
+}
+
+func (c *IssueGraphCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	if c.IssueID == "" && c.Project == "" && c.Cycle == "" {
+		return exitError(2, errors.New("an issue ID, --project, or --cycle is required"))
+	}
+	if c.Depth < 0 {
+		return exitError(2, fmt.Errorf("invalid depth %d", c.Depth))
+	}
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+
+	graph := newIssueGraph()
+	seeds, err := c.seeds(ctx, client, graph)
+	if err != nil {
+		return err
+	}
+	if err := graph.walk(ctx, client, seeds, c.Depth); err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	graph.analyze()
+
+	for _, cycle := range graph.Cycles {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Err, "warning: dependency cycle between %s\n", strings.Join(cycle, ", "))
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(graph)
+	}
+	if c.Format == "mermaid" {
+		graph.writeMermaid(cmdCtx.deps.Out)
+	} else {
+		graph.writeDOT(cmdCtx.deps.Out)
+	}
+	return nil
+}
+
+func (c *IssueGraphCmd) seeds(ctx context.Context, client linear.API, graph *issueGraph) ([]string, error) {
+	if c.IssueID != "" {
+		issue, err := client.Issue(ctx, c.IssueID)
+		if err != nil {
+			return nil, exitError(mapErrorToExitCode(err), err)
+		}
+		graph.addNode(linear.IssueRef{
+			ID:         issue.ID,
+			Identifier: issue.Identifier,
+			Title:      issue.Title,
+			State:      issue.State,
+			StateType:  issue.StateType,
+		})
+		return []string{issue.ID}, nil
+	}
+
+	filter := linear.IssueFilter{}
+	if c.Team != "" {
+		teamID, err := client.ResolveTeamID(ctx, c.Team)
+		if err != nil {
+			return nil, exitError(mapErrorToExitCode(err), err)
+		}
+		filter.TeamID = teamID
+	}
+	if c.Project != "" {
+		projectID, err := client.ResolveProjectID(ctx, c.Project)
+		if err != nil {
+			return nil, exitError(mapErrorToExitCode(err), err)
+		}
+		filter.ProjectID = projectID
+	}
+	if c.Cycle != "" {
+		if filter.TeamID == "" && looksLikeID(c.Cycle) {
+			filter.CycleID = c.Cycle
+		} else {
+			if filter.TeamID == "" {
+				return nil, exitError(2, errors.New("--cycle requires --team to resolve 'current'"))
+			}
+			cycleID, err := client.ResolveCycleID(ctx, filter.TeamID, c.Cycle)
+			if err != nil {
+				return nil, exitError(mapErrorToExitCode(err), err)
+			}
+			filter.CycleID = cycleID
+		}
+	}
+
+	seeds := []string{}
+	after := ""
+	for len(seeds) < graphScopeLimit {
+		page, err := client.Issues(ctx, filter, 100, after)
+		if err != nil {
+			return nil, exitError(mapErrorToExitCode(err), err)
+		}
+		for _, issue := range page.Nodes {
+			graph.addNode(linear.IssueRef{
+				ID:         issue.ID,
+				Identifier: issue.Identifier,
+				Title:      issue.Title,
+				State:      issue.State,
+				StateType:  issue.StateType,
+			})
+			seeds = append(seeds, issue.ID)
+		}
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			break
+		}
+		after = page.PageInfo.EndCursor
+	}
+	return seeds, nil
+}
+
+func (g *issueGraph) addNode(ref linear.IssueRef) {
+	if _, ok := g.index[ref.ID]; ok {
+		return
+	}
+	g.index[ref.ID] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, ref)
+}
+
+func (g *issueGraph) addEdge(fromID, toID string) {
+	edge := graphEdge{From: fromID, To: toID}
+	if _, ok := g.edges[edge]; ok {
+		return
+	}
+	g.edges[edge] = struct{}{}
+	g.Edges = append(g.Edges, edge)
+}
+
+func (g *issueGraph) walk(ctx context.Context, client linear.API, seeds []string, maxDepth int) error {
+	depth := map[string]int{}
+	queue := []string{}
+	for _, id := range seeds {
+		if _, ok := depth[id]; !ok {
+			depth[id] = 0
+			queue = append(queue, id)
+		}
+	}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		relations, err := client.IssueRelations(ctx, id, 200)
+		if err != nil {
+			return err
+		}
+		visit := func(rel linear.IssueRelation, next string) {
+			if !strings.EqualFold(rel.Type, linear.RelationBlocks) {
+				return
+			}
+			_, seen := depth[next]
+			if !seen && maxDepth > 0 && depth[id]+1 > maxDepth {
+				return
+			}
+			g.addNode(rel.Issue)
+			g.addNode(rel.RelatedIssue)
+			g.addEdge(rel.IssueID, rel.RelatedIssueID)
+			if !seen {
+				depth[next] = depth[id] + 1
+				queue = append(queue, next)
+			}
+		}
+		for _, rel := range relations.Relations {
+			visit(rel, rel.RelatedIssueID)
+		}
+		for _, rel := range relations.InverseRelations {
+			visit(rel, rel.IssueID)
+		}
+	}
+	return nil
+}
+
+func (g *issueGraph) analyze() {
+	adjacency := make([][]int, len(g.Nodes))
+	for _, edge := range g.Edges {
+		from, to := g.index[edge.From], g.index[edge.To]
+		adjacency[from] = append(adjacency[from], to)
+	}
+
+	component := stronglyConnected(adjacency)
+	sizes := map[int]int{}
+	for _, comp := range component {
+		sizes[comp]++
+	}
+	cycles := map[int][]string{}
+	order := []int{}
+	for i, comp := range component {
+		selfLoop := false
+		for _, to := range adjacency[i] {
+			if to == i {
+				selfLoop = true
+			}
+		}
+		if sizes[comp] < 2 && !selfLoop {
+			continue
+		}
+		if _, ok := cycles[comp]; !ok {
+			order = append(order, comp)
+		}
+		cycles[comp] = append(cycles[comp], g.Nodes[i].Identifier)
+	}
+	for _, comp := range order {
+		g.Cycles = append(g.Cycles, cycles[comp])
+	}
+
+	// Longest chain of unfinished issues, ignoring edges inside cycles.
+	open := func(i int) bool {
+		return g.Nodes[i].StateType != "completed" && g.Nodes[i].StateType != "canceled"
+	}
+	length := make([]int, len(g.Nodes))
+	next := make([]int, len(g.Nodes))
+	done := make([]bool, len(g.Nodes))
+	var longest func(i int) int
+	longest = func(i int) int {
+		if done[i] {
+			return length[i]
+		}
+		done[i] = true
+		length[i], next[i] = 1, -1
+		for _, to := range adjacency[i] {
+			if component[to] == component[i] || !open(to) {
+				continue
+			}
+			if l := longest(to) + 1; l > length[i] {
+				length[i], next[i] = l, to
+			}
+		}
+		return length[i]
+	}
+	start, best := -1, 1
+	for i := range g.Nodes {
+		if !open(i) {
+			continue
+		}
+		if l := longest(i); l > best {
+			start, best = i, l
+		}
+	}
+	for i := start; i >= 0; i = next[i] {
+		g.CriticalPath = append(g.CriticalPath, g.Nodes[i].Identifier)
+	}
+
+	for i := range g.Edges {
+		g.Edges[i] = graphEdge{From: g.identifier(g.Edges[i].From), To: g.identifier(g.Edges[i].To)}
+	}
+}
+
+func (g *issueGraph) identifier(id string) string {
+	return g.Nodes[g.index[id]].Identifier
+}
+
+func stronglyConnected(adjacency [][]int) []int {
+	index := 0
+	indices := make([]int, len(adjacency))
+	lowlink := make([]int, len(adjacency))
+	onStack := make([]bool, len(adjacency))
+	component := make([]int, len(adjacency))
+	for i := range indices {
+		indices[i] = -1
+	}
+	stack := []int{}
+	count := 0
+
+	var connect func(v int)
+	connect = func(v int) {
+		indices[v], lowlink[v] = index, index
+		index++
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range adjacency[v] {
+			if indices[w] < 0 {
+				connect(w)
+				lowlink[v] = min(lowlink[v], lowlink[w])
+			} else if onStack[w] {
+				lowlink[v] = min(lowlink[v], indices[w])
+			}
+		}
+		if lowlink[v] != indices[v] {
+			return
+		}
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component[w] = count
+			if w == v {
+				break
+			}
+		}
+		count++
+	}
+	for v := range adjacency {
+		if indices[v] < 0 {
+			connect(v)
+		}
+	}
+	return component
+}
+
+func (g *issueGraph) highlights() (critical map[string]bool, criticalEdges map[graphEdge]bool, cyclic map[string]bool) {
+	critical = map[string]bool{}
+	criticalEdges = map[graphEdge]bool{}
+	for i, id := range g.CriticalPath {
+		critical[id] = true
+		if i > 0 {
+			criticalEdges[graphEdge{From: g.CriticalPath[i-1], To: id}] = true
+		}
+	}
+	cyclic = map[string]bool{}
+	for _, cycle := range g.Cycles {
+		for _, id := range cycle {
+			cyclic[id] = true
+		}
+	}
+	return critical, criticalEdges, cyclic
+}
+
+func (g *issueGraph) writeDOT(w io.Writer) {
+	critical, criticalEdges, cyclic := g.highlights()
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace
+	quote := func(value string) string {
+		return `"` + escape(value) + `"`
+	}
+
+	_, _ = fmt.Fprintln(w, "digraph issues {")
+	_, _ = fmt.Fprintln(w, "  rankdir=LR;")
+	_, _ = fmt.Fprintln(w, "  node [shape=box, style=rounded];")
+	for _, node := range g.Nodes {
+		// Escape each part on its own so the \n line breaks reach Graphviz as-is.
+		label := `"` + escape(node.Identifier) + `\n` + escape(node.Title) + `\n[` + escape(node.State) + `]"`
+		attrs := []string{"label=" + label}
+		if node.StateType == "completed" || node.StateType == "canceled" {
+			attrs = append(attrs, `style="rounded,filled"`, `fillcolor="#e0e0e0"`)
+		}
+		if critical[node.Identifier] {
+			attrs = append(attrs, `color="#d33"`, "penwidth=2")
+		}
+		_, _ = fmt.Fprintf(w, "  %s [%s];\n", quote(node.Identifier), strings.Join(attrs, ", "))
+	}
+	for _, edge := range g.Edges {
+		attrs := ""
+		switch {
+		case criticalEdges[edge]:
+			attrs = ` [color="#d33", penwidth=2]`
+		case cyclic[edge.From] && cyclic[edge.To]:
+			attrs = ` [color="#f90", style=dashed]`
+		}
+		_, _ = fmt.Fprintf(w, "  %s -> %s%s;\n", quote(edge.From), quote(edge.To), attrs)
+	}
+	_, _ = fmt.Fprintln(w, "}")
+}
+
+func (g *issueGraph) writeMermaid(w io.Writer) {
+	critical, criticalEdges, cyclic := g.highlights()
+	nodeID := func(identifier string) string {
+		return strings.NewReplacer("-", "_", " ", "_").Replace(identifier)
+	}
+	label := func(value string) string {
+		return strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;").Replace(value)
+	}
+
+	_, _ = fmt.Fprintln(w, "flowchart LR")
+	doneNodes := []string{}
+	criticalNodes := []string{}
+	for _, node := range g.Nodes {
+		_, _ = fmt.Fprintf(w, "  %s[\"%s: %s<br/>%s\"]\n", nodeID(node.Identifier), node.Identifier, label(node.Title), label(node.State))
+		if node.StateType == "completed" || node.StateType == "canceled" {
+			doneNodes = append(doneNodes, nodeID(node.Identifier))
+		}
+		if critical[node.Identifier] {
+			criticalNodes = append(criticalNodes, nodeID(node.Identifier))
+		}
+	}
+	criticalLinks := []string{}
+	cycleLinks := []string{}
+	for i, edge := range g.Edges {
+		_, _ = fmt.Fprintf(w, "  %s --> %s\n", nodeID(edge.From), nodeID(edge.To))
+		switch {
+		case criticalEdges[edge]:
+			criticalLinks = append(criticalLinks, fmt.Sprint(i))
+		case cyclic[edge.From] && cyclic[edge.To]:
+			cycleLinks = append(cycleLinks, fmt.Sprint(i))
+		}
+	}
+	_, _ = fmt.Fprintln(w, "  classDef done fill:#e0e0e0,color:#666;")
+	_, _ = fmt.Fprintln(w, "  classDef critical stroke:#d33,stroke-width:3px;")
+	if len(doneNodes) > 0 {
+		_, _ = fmt.Fprintf(w, "  class %s done;\n", strings.Join(doneNodes, ","))
+	}
+	if len(criticalNodes) > 0 {
+		_, _ = fmt.Fprintf(w, "  class %s critical;\n", strings.Join(criticalNodes, ","))
+	}
+	if len(criticalLinks) > 0 {
+		_, _ = fmt.Fprintf(w, "  linkStyle %s stroke:#d33,stroke-width:3px;\n", strings.Join(criticalLinks, ","))
+	}
+	if len(cycleLinks) > 0 {
+		_, _ = fmt.Fprintf(w, "  linkStyle %s stroke:#f90,stroke-dasharray:4;\n", strings.Join(cycleLinks, ","))
+	}
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func newGraphTestAPI() *fakeAPI {
+	refs := map[string]linear.IssueRef{}
+	for _, ref := range []linear.IssueRef{
+		{ID: "1", Identifier: "ENG-1", Title: "Schema", State: "Done", StateType: "completed"},
+		{ID: "2", Identifier: "ENG-2", Title: "API", State: "In Progress", StateType: "started"},
+		{ID: "3", Identifier: "ENG-3", Title: "Client", State: "Todo", StateType: "unstarted"},
+		{ID: "4", Identifier: "ENG-4", Title: "Launch", State: "Todo", StateType: "unstarted"},
+		{ID: "5", Identifier: "ENG-5", Title: "Loop A", State: "Todo", StateType: "unstarted"},
+		{ID: "6", Identifier: "ENG-6", Title: "Loop B", State: "Todo", StateType: "unstarted"},
+	} {
+		refs[ref.ID] = ref
+	}
+	blocks := [][2]string{{"1", "2"}, {"2", "3"}, {"3", "4"}, {"2", "4"}, {"4", "5"}, {"5", "6"}, {"6", "5"}}
+
+	api := newFakeAPI(linear.IssueDetail{ID: "4", Identifier: "ENG-4", Title: "Launch", State: "Todo", StateType: "unstarted"})
+	api.relationsByIssue = map[string]linear.IssueRelationSet{}
+	for i, pair := range blocks {
+		rel := linear.IssueRelation{
+			ID:             "rel-" + string(rune('a'+i)),
+			Type:           "blocks",
+			IssueID:        pair[0],
+			RelatedIssueID: pair[1],
+			Issue:          refs[pair[0]],
+			RelatedIssue:   refs[pair[1]],
+		}
+		from, to := api.relationsByIssue[pair[0]], api.relationsByIssue[pair[1]]
+		from.Relations = append(from.Relations, rel)
+		api.relationsByIssue[pair[0]] = from
+		to.InverseRelations = append(to.InverseRelations, rel)
+		api.relationsByIssue[pair[1]] = to
+	}
+	return api
+}
+
+func TestIssueGraph(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		code  int
+		check func(t *testing.T, c *cliTest)
+	}{
+		{
+			name: "detects cycles and critical path",
+			args: []string{"--json", "issue", "graph", "ENG-4", "--depth", "0"},
+			check: func(t *testing.T, c *cliTest) {
+				out := c.out.String()
+				for _, want := range []string{`"ENG-5"`, `"ENG-6"`} {
+					if !strings.Contains(out, want) {
+						t.Fatalf("expected %s in output, got %s", want, out)
+					}
+				}
+				if !strings.Contains(c.errOut.String(), "dependency cycle between ENG-5, ENG-6") && !strings.Contains(c.errOut.String(), "dependency cycle between ENG-6, ENG-5") {
+					t.Fatalf("expected cycle warning, got %q", c.errOut.String())
+				}
+				if !strings.Contains(out, "\"critical_path\": [\n    \"ENG-2\",\n    \"ENG-3\",\n    \"ENG-4\",") {
+					t.Fatalf("unexpected critical path in %s", out)
+				}
+			},
+		},
+		{
+			name: "dot with depth",
+			args: []string{"issue", "graph", "ENG-4", "--depth", "1"},
+			check: func(t *testing.T, c *cliTest) {
+				dot := c.out.String()
+				if !strings.HasPrefix(dot, "digraph issues {") || !strings.Contains(dot, `"ENG-3" -> "ENG-4" [color="#d33", penwidth=2];`) {
+					t.Fatalf("unexpected DOT output:\n%s", dot)
+				}
+				if !strings.Contains(dot, `label="ENG-4\nLaunch\n[Todo]"`) {
+					t.Fatalf("expected line breaks in the ENG-4 label:\n%s", dot)
+				}
+				if strings.Contains(dot, `"ENG-1" ->`) {
+					t.Fatalf("expected depth 1 to stop before ENG-1:\n%s", dot)
+				}
+			},
+		},
+		{
+			name: "mermaid",
+			args: []string{"issue", "graph", "ENG-4", "--format", "mermaid"},
+			check: func(t *testing.T, c *cliTest) {
+				mermaid := c.out.String()
+				if !strings.HasPrefix(mermaid, "flowchart LR\n") || !strings.Contains(mermaid, "ENG_2 --> ENG_3") || !strings.Contains(mermaid, "class ENG_1 done;") {
+					t.Fatalf("unexpected Mermaid output:\n%s", mermaid)
+				}
+			},
+		},
+		{name: "requires scope", args: []string{"issue", "graph"}, code: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newCLITest(t, newGraphTestAPI())
+			c.run(tt.code, tt.args...)
+			if tt.check != nil {
+				tt.check(t, c)
+			}
+		})
+	}
+}
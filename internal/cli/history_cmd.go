@@ -0,0 +1,370 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/journal"
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+type HistoryCmd struct {
+	Limit int `help:"Number of operations to show" default:"20"`
+}
+
+type UndoCmd struct {
+	ID string `arg:"" optional:"" name:"op-id" help:"Operation or run ID to undo (defaults to the most recent run)"`
+}
+
+type historyEntry struct {
+	journal.Entry
+	Undone bool `json:"undone"`
+}
+
+type undoResult struct {
+	ID     string `json:"id"`
+	Op     string `json:"op"`
+	Issue  string `json:"issue,omitempty"`
+	Status string `json:"status"`
+	Detail string `json:"detail,omitempty"`
+}
+
+var fieldNames = map[string]string{
+	"teamId":     "team",
+	"stateId":    "state",
+	"assigneeId": "assignee",
+	"projectId":  "project",
+	"cycleId":    "cycle",
+	"labelIds":   "labels",
+	"parentId":   "parent",
+	"dueDate":    "due date",
+}
+
+func (c *HistoryCmd) Run(cmdCtx *commandContext) error {
+	if c.Limit <= 0 {
+		return exitError(2, errors.New("--limit must be greater than zero"))
+	}
+	entries, err := loadJournal(cmdCtx)
+	if err != nil {
+		return err
+	}
+	undone := undoneEntries(entries)
+
+	history := []historyEntry{}
+	for i := len(entries) - 1; i >= 0 && len(history) < c.Limit; i-- {
+		history = append(history, historyEntry{Entry: entries[i], Undone: undone[entries[i].ID]})
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(history)
+	}
+	rows := make([][]string, 0, len(history))
+	for _, entry := range history {
+		status := ""
+		switch {
+		case entry.Undone:
+			status = "undone"
+		case entry.UndoOf != "":
+			status = "undo of " + entry.UndoOf
+		}
+		rows = append(rows, []string{entry.ID, entry.Run, formatTime(entry.Time), entry.Command, entry.Op, entry.Issue, displayFields(entry.Fields), status})
+	}
+	return out.PrintTable([]string{"ID", "Run", "Time", "Command", "Op", "Issue", "Fields", "Status"}, rows)
+}
+
+func (c *UndoCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	entries, err := loadJournal(cmdCtx)
+	if err != nil {
+		return err
+	}
+	targets, err := undoTargets(entries, c.ID)
+	if err != nil {
+		return err
+	}
+
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	journaled, _ := client.(*journalAPI)
+
+	results := make([]undoResult, 0, len(targets))
+	failed := 0
+	for _, entry := range targets {
+		if journaled != nil {
+			journaled.undoOf = entry.ID
+		}
+		result := undoResult{ID: entry.ID, Op: entry.Op, Issue: entry.Issue, Status: "undone"}
+		detail, err := undoEntry(ctx, client, entry)
+		if err != nil {
+			result.Status = "failed"
+			detail = err.Error()
+			failed++
+		}
+		result.Detail = detail
+		results = append(results, result)
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		if err := out.PrintJSON(results); err != nil {
+			return err
+		}
+	} else {
+		rows := make([][]string, 0, len(results))
+		for _, result := range results {
+			rows = append(rows, []string{result.ID, result.Op, result.Issue, result.Status, result.Detail})
+		}
+		if err := out.PrintTable([]string{"ID", "Op", "Issue", "Status", "Detail"}, rows); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return exitError(1, fmt.Errorf("%d of %d operations could not be undone", failed, len(results)))
+	}
+	return nil
+}
+
+func loadJournal(cmdCtx *commandContext) ([]journal.Entry, error) {
+	if cmdCtx.deps.Journal == nil {
+		return nil, exitError(1, errors.New("journal is not available"))
+	}
+	entries, err := cmdCtx.deps.Journal.List()
+	if err != nil {
+		return nil, exitError(1, err)
+	}
+	return entries, nil
+}
+
+func undoneEntries(entries []journal.Entry) map[string]bool {
+	undone := map[string]bool{}
+	for _, entry := range entries {
+		if entry.UndoOf != "" {
+			undone[entry.UndoOf] = true
+		}
+	}
+	return undone
+}
+
+// undoTargets picks the entries to reverse, newest first. An ID matches a
+// single operation or every operation of a run; without one, the latest run
+// that still has operations left to undo is used.
+func undoTargets(entries []journal.Entry, id string) ([]journal.Entry, error) {
+	undone := undoneEntries(entries)
+
+	if id != "" {
+		for _, entry := range entries {
+			if entry.ID != id {
+				continue
+			}
+			if undone[entry.ID] {
+				return nil, exitError(1, fmt.Errorf("operation %s was already undone", id))
+			}
+			return []journal.Entry{entry}, nil
+		}
+	} else {
+		for i := len(entries) - 1; i >= 0; i-- {
+			if entries[i].UndoOf == "" && !undone[entries[i].ID] {
+				id = entries[i].Run
+				break
+			}
+		}
+		if id == "" {
+			return nil, exitError(4, errors.New("nothing to undo"))
+		}
+	}
+
+	targets := []journal.Entry{}
+	matched := false
+	for i := len(entries) - 1; i >= 0; i-- {
+		if entries[i].Run != id {
+			continue
+		}
+		matched = true
+		if !undone[entries[i].ID] {
+			targets = append(targets, entries[i])
+		}
+	}
+	if !matched {
+		return nil, exitError(4, fmt.Errorf("operation or run %q not found in journal", id))
+	}
+	if len(targets) == 0 {
+		return nil, exitError(1, fmt.Errorf("run %s was already undone", id))
+	}
+	return targets, nil
+}
+
+func undoEntry(ctx context.Context, client linear.API, entry journal.Entry) (string, error) {
+	switch entry.Op {
+	case opIssueUpdate:
+		return undoIssueUpdate(ctx, client, entry)
+	case opIssueCreate:
+		return "moved to the trash", client.IssueDelete(ctx, entry.IssueID, false)
+	case opIssueArchive:
+		return "unarchived", client.IssueUnarchive(ctx, entry.IssueID)
+	case opIssueUnarchive:
+		return "archived", client.IssueArchive(ctx, entry.IssueID)
+	case opIssueDelete:
+		var state deleteState
+		if err := json.Unmarshal(entry.After, &state); err != nil || state.Permanent {
+			return "", errors.New("permanently deleted issues cannot be restored")
+		}
+		return "restored from the trash", client.IssueUnarchive(ctx, entry.IssueID)
+	case opIssueSubscribe, opIssueUnsubscribe:
+		var state subscriptionState
+		if err := json.Unmarshal(entry.After, &state); err != nil {
+			return "", errors.New("subscriber was not recorded")
+		}
+		if entry.Op == opIssueSubscribe {
+			return "unsubscribed", client.IssueUnsubscribe(ctx, entry.IssueID, state.UserID)
+		}
+		return "subscribed", client.IssueSubscribe(ctx, entry.IssueID, state.UserID)
+	case opCommentCreate:
+		var state commentState
+		if err := json.Unmarshal(entry.After, &state); err != nil || state.CommentID == "" {
+			return "", errors.New("comment ID was not recorded")
+		}
+		return "comment deleted", client.CommentDelete(ctx, state.CommentID)
+	case opCommentUpdate:
+		var state commentState
+		if err := json.Unmarshal(entry.Before, &state); err != nil || state.CommentID == "" {
+			return "", errors.New("previous comment body was not recorded")
+		}
+		return "comment restored", client.CommentUpdate(ctx, state.CommentID, state.Body)
+	case opCommentResolve, opCommentUnresolve:
+		var state commentState
+		if err := json.Unmarshal(entry.Before, &state); err != nil || state.CommentID == "" {
+			return "", errors.New("comment ID was not recorded")
+		}
+		if entry.Op == opCommentResolve {
+			return "thread reopened", client.CommentUnresolve(ctx, state.CommentID)
+		}
+		return "thread resolved", client.CommentResolve(ctx, state.CommentID)
+	case opReactionCreate:
+		var state reactionState
+		if err := json.Unmarshal(entry.After, &state); err != nil || state.ID == "" {
+			return "", errors.New("reaction was not recorded")
+		}
+		return "reaction removed", client.ReactionDelete(ctx, state.ID)
+	case opReactionDelete:
+		var state reactionState
+		if len(entry.Before) == 0 || json.Unmarshal(entry.Before, &state) != nil || state.Emoji == "" {
+			return "", errors.New("removed reaction was not recorded")
+		}
+		_, err := client.ReactionCreate(ctx, state.IssueID, state.CommentID, state.Emoji)
+		return "reaction restored", err
+	case opRelationCreate:
+		var rel linear.IssueRelation
+		if err := json.Unmarshal(entry.After, &rel); err != nil || rel.ID == "" {
+			return "", errors.New("relation was not recorded")
+		}
+		if journaled, ok := client.(*journalAPI); ok {
+			journaled.remember(rel)
+		}
+		return "relation removed", client.IssueRelationDelete(ctx, rel.ID)
+	case opRelationDelete:
+		var rel linear.IssueRelation
+		if len(entry.Before) == 0 || json.Unmarshal(entry.Before, &rel) != nil || rel.IssueID == "" {
+			return "", errors.New("deleted relation was not recorded")
+		}
+		_, err := client.IssueRelationCreate(ctx, rel.IssueID, rel.RelatedIssueID, rel.Type)
+		return "relation restored", err
+	case opLinkCreate:
+		var before linkState
+		if len(entry.Before) > 0 && json.Unmarshal(entry.Before, &before) == nil && before.URL != "" {
+			// The link replaced an existing one with the same URL: put back its
+			// title and subtitle instead of removing it.
+			_, err := client.AttachmentCreate(ctx, linear.AttachmentCreateInput{
+				IssueID:  before.IssueID,
+				Title:    before.Title,
+				Subtitle: before.Subtitle,
+				URL:      before.URL,
+			})
+			return "link restored", err
+		}
+		var state linkState
+		if err := json.Unmarshal(entry.After, &state); err != nil || state.ID == "" {
+			return "", errors.New("link was not recorded")
+		}
+		if journaled, ok := client.(*journalAPI); ok {
+			journaled.rememberLinks(state)
+		}
+		return "link removed", client.AttachmentDelete(ctx, state.ID)
+	case opLinkDelete:
+		var state linkState
+		if len(entry.Before) == 0 || json.Unmarshal(entry.Before, &state) != nil || state.URL == "" {
+			return "", errors.New("removed link was not recorded")
+		}
+		_, err := client.AttachmentCreate(ctx, linear.AttachmentCreateInput{
+			IssueID:  state.IssueID,
+			Title:    state.Title,
+			Subtitle: state.Subtitle,
+			URL:      state.URL,
+		})
+		return "link restored", err
+	default:
+		return "", fmt.Errorf("%s cannot be undone", entry.Op)
+	}
+}
+
+// undoIssueUpdate restores the fields an update changed, skipping any field
+// that has been changed again since so newer edits are not clobbered.
+func undoIssueUpdate(ctx context.Context, client linear.API, entry journal.Entry) (string, error) {
+	var before, after map[string]any
+	if err := json.Unmarshal(entry.Before, &before); err != nil {
+		return "", errors.New("previous values were not recorded")
+	}
+	if len(entry.After) > 0 {
+		if err := json.Unmarshal(entry.After, &after); err != nil {
+			return "", fmt.Errorf("decode journal entry: %w", err)
+		}
+	}
+	current, err := client.Issue(ctx, entry.IssueID)
+	if err != nil {
+		return "", err
+	}
+	snapshot := issueSnapshot(current)
+
+	var restore, skipped []string
+	for _, field := range entry.Fields {
+		if after != nil && string(mustJSON(snapshot[field])) != string(mustJSON(after[field])) {
+			skipped = append(skipped, field)
+			continue
+		}
+		restore = append(restore, field)
+	}
+	if len(restore) == 0 {
+		return "", fmt.Errorf("%s changed since; nothing restored", displayFields(skipped))
+	}
+
+	input, err := restoreInput(before, restore)
+	if err != nil {
+		return "", err
+	}
+	if _, err := client.IssueUpdate(ctx, entry.IssueID, input); err != nil {
+		return "", err
+	}
+	detail := "restored " + displayFields(restore)
+	if len(skipped) > 0 {
+		detail += "; skipped " + displayFields(skipped) + " (changed since)"
+	}
+	return detail, nil
+}
+
+func displayFields(fields []string) string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if name, ok := fieldNames[field]; ok {
+			field = name
+		}
+		if !slices.Contains(names, field) {
+			names = append(names, field)
+		}
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/journal"
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func newJournalTestAPI() *fakeAPI {
+	return newFakeAPI(linear.IssueDetail{ID: "issue-1", Identifier: "ENG-1", Title: "Old title", TeamID: "team-1", StateID: "state-todo", Priority: linear.Priority(3)})
+}
+
+func TestUndo(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		// edit changes the issue between the command and the undo.
+		edit  func(api *fakeAPI)
+		code  int
+		check func(t *testing.T, api *fakeAPI, c *cliTest)
+	}{
+		{
+			name: "restores issue update",
+			args: []string{"issue", "update", "ENG-1", "--title", "New title", "--priority", "urgent"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				entries, err := c.deps.Journal.List()
+				if err != nil || len(entries) != 2 {
+					t.Fatalf("expected the update and its undo, got %v (err %v)", entries, err)
+				}
+				entry := entries[0]
+				if entry.Op != opIssueUpdate || entry.Issue != "ENG-1" || entry.Command != "issue update" {
+					t.Fatalf("unexpected entry %+v", entry)
+				}
+				if strings.Join(entry.Fields, ",") != "priority,title" {
+					t.Fatalf("unexpected fields %v", entry.Fields)
+				}
+				if entries[1].UndoOf != entry.ID {
+					t.Fatalf("expected undo to be journaled against %s, got %+v", entry.ID, entries)
+				}
+				if len(api.updates) != 2 {
+					t.Fatalf("expected a restoring update, got %d updates", len(api.updates))
+				}
+				vars := api.updates[1].Input.Variables()
+				if vars["title"] != "Old title" || vars["priority"] != 3 || len(vars) != 2 {
+					t.Fatalf("unexpected restore variables %v", vars)
+				}
+				c.run(1, "undo", entry.ID)
+			},
+		},
+		{
+			name: "skips fields changed since",
+			args: []string{"issue", "update", "ENG-1", "--title", "New title"},
+			edit: func(api *fakeAPI) {
+				issue := api.issues["ENG-1"]
+				issue.Title = "Edited elsewhere"
+				api.issues["ENG-1"] = issue
+			},
+			code: 1,
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if len(api.updates) != 1 {
+					t.Fatalf("expected no restoring update, got %d updates", len(api.updates))
+				}
+				if !strings.Contains(c.out.String(), "title changed since") {
+					t.Fatalf("expected conflict detail, got %q", c.out.String())
+				}
+			},
+		},
+		{
+			name: "deletes comment",
+			args: []string{"issue", "comment", "ENG-1", "--body", "hello"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.deletedComments) != 1 || api.deletedComments[0] != "comment-1" {
+					t.Fatalf("expected comment-1 to be deleted, got %v", api.deletedComments)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newJournalTestAPI()
+			c := newCLITest(t, api).withJournal()
+			c.run(0, tt.args...)
+			if tt.edit != nil {
+				tt.edit(api)
+			}
+			c.run(tt.code, "undo")
+			tt.check(t, api, c)
+		})
+	}
+}
+
+func TestUndoRelationDelete(t *testing.T) {
+	api := newJournalTestAPI()
+	api.relations = linear.IssueRelationSet{Relations: []linear.IssueRelation{
+		{ID: "rel-1", IssueID: "issue-1", RelatedIssueID: "issue-2", Type: "blocks", Issue: linear.IssueRef{Identifier: "ENG-1"}},
+	}}
+	c := newCLITest(t, api).withJournal()
+
+	client := (&commandContext{deps: c.deps, global: &GlobalOptions{}, run: "run-1"}).journaled(api)
+	if _, err := client.IssueRelations(t.Context(), "issue-1", 50); err != nil {
+		t.Fatalf("IssueRelations() error: %v", err)
+	}
+	if err := client.IssueRelationDelete(t.Context(), "rel-1"); err != nil {
+		t.Fatalf("IssueRelationDelete() error: %v", err)
+	}
+	c.run(0, "undo", "run-1")
+	if len(api.createdRelations) != 1 || api.createdRelations[0].RelatedIssueID != "issue-2" || api.createdRelations[0].Type != "blocks" {
+		t.Fatalf("expected relation to be restored, got %v", api.createdRelations)
+	}
+}
+
+func TestHistoryListsNewestFirst(t *testing.T) {
+	c := newCLITest(t, newJournalTestAPI()).withJournal()
+	for _, op := range []string{opIssueCreate, opIssueArchive} {
+		if err := c.deps.Journal.Append(journal.Entry{ID: op, Run: "run-1", Op: op, Issue: "ENG-1"}); err != nil {
+			t.Fatalf("Append() error: %v", err)
+		}
+	}
+	if err := c.deps.Journal.Append(journal.Entry{ID: "undo-1", Run: "run-2", Op: opIssueUnarchive, UndoOf: opIssueArchive}); err != nil {
+		t.Fatalf("Append() error: %v", err)
+	}
+
+	c.run(0, "history", "--json", "--limit", "2")
+	var history []historyEntry
+	if err := json.Unmarshal(c.out.Bytes(), &history); err != nil {
+		t.Fatalf("decode history: %v", err)
+	}
+	if len(history) != 2 || history[0].ID != "undo-1" || history[1].ID != opIssueArchive || !history[1].Undone {
+		t.Fatalf("unexpected history %+v", history)
+	}
+}
@@ -3,9 +3,12 @@ package cli
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/duailibe/linear-cli/internal/linear"
@@ -16,62 +19,113 @@ type IssueCmd struct {
 	View        IssueViewCmd        `cmd:"" help:"View issue details"`
 	Create      IssueCreateCmd      `cmd:"" help:"Create an issue"`
 	Update      IssueUpdateCmd      `cmd:"" help:"Update an issue"`
+	BulkUpdate  IssueBulkUpdateCmd  `cmd:"" name:"bulk-update" help:"Apply the same update to every issue matching a filter"`
 	Close       IssueCloseCmd       `cmd:"" help:"Close an issue"`
 	Reopen      IssueReopenCmd      `cmd:"" help:"Reopen an issue"`
+	Archive     IssueArchiveCmd     `cmd:"" help:"Archive an issue"`
+	Unarchive   IssueUnarchiveCmd   `cmd:"" help:"Restore an archived issue"`
+	Delete      IssueDeleteCmd      `cmd:"" help:"Move an issue to the trash"`
+	Move        IssueMoveCmd        `cmd:"" help:"Move an issue to another team"`
+	Subscribe   IssueSubscribeCmd   `cmd:"" help:"Subscribe users to an issue"`
+	Unsubscribe IssueUnsubscribeCmd `cmd:"" help:"Unsubscribe users from an issue"`
 	Comment     IssueCommentCmd     `cmd:"" help:"Add a comment to an issue"`
+	Attach      IssueAttachCmd      `cmd:"" help:"Upload files to an issue"`
+	Link        IssueLinkCmd        `cmd:"" help:"Link a URL to an issue"`
+	Links       IssueLinksCmd       `cmd:"" help:"List the links on an issue"`
+	Unlink      IssueUnlinkCmd      `cmd:"" help:"Remove a link from an issue"`
 	Uploads     IssueUploadsCmd     `cmd:"" help:"Download issue uploads from the issue description and comments"`
+	Templates   IssueTemplatesCmd   `cmd:"" help:"List issue templates"`
+	Children    IssueChildrenCmd    `cmd:"" help:"List sub-issues as a tree"`
+	Relate      IssueRelateCmd      `cmd:"" help:"Add relations to an issue"`
+	Unrelate    IssueUnrelateCmd    `cmd:"" help:"Remove relations from an issue"`
+	Graph       IssueGraphCmd       `cmd:"" help:"Export the blocks dependency graph as DOT or Mermaid"`
+	History     IssueHistoryCmd     `cmd:"" help:"Show the change history of an issue"`
 }
 
 type IssueListCmd struct {
-	Team     string `help:"Team key or ID"`
-	Assignee string `help:"Assignee (me, id, or email)"`
-	State    string `help:"Workflow state name or ID"`
-	Labels   string `name:"label" help:"Comma-separated label names or IDs"`
-	Project  string `help:"Project name or ID"`
-	Cycle    string `help:"Cycle ID or 'current'"`
-	Search   string `help:"Search issue titles"`
-	Priority int    `help:"Priority (0-4)" default:"-1"`
-	Limit    int    `help:"Maximum number of issues" default:"50"`
-	After    string `help:"Pagination cursor"`
+	Team       string `help:"Team key or ID"`
+	Assignee   string `help:"Assignee (me, id, or email)" xor:"assignee"`
+	Unassigned bool   `help:"Only show unassigned issues" xor:"assignee"`
+	State      string `help:"Workflow state name or ID"`
+	Labels     string `name:"label" help:"Comma-separated label names or IDs"`
+	Project    string `help:"Project name or ID"`
+	Cycle      string `help:"Cycle ID or 'current'"`
+	Search     string `help:"Search issue titles"`
+	Priority   string `help:"Priority or range (none, urgent, high, medium, low, or 0-4; e.g. high..urgent)"`
+	View       string `help:"Custom view name or ID to list issues from"`
+	Parent     string `help:"Only show sub-issues of this issue (ID or key)"`
+	Subscriber string `help:"Only show issues this user is subscribed to (me, id, or email)"`
+	Archived   bool   `name:"include-archived" help:"Include archived issues"`
+	Limit      int    `help:"Maximum number of issues" default:"50"`
+	After      string `help:"Pagination cursor"`
+	Format     string `help:"Output format (table or ndjson)" enum:"table,ndjson" default:"table"`
 }
 
 type IssueViewCmd struct {
-	IssueID          string `arg:"" name:"issue-id" help:"Issue ID"`
-	Comments         bool   `help:"Include comments"`
-	CommentsLimit    int    `name:"comments-limit" help:"Maximum number of comments" default:"20"`
-	Uploads          bool   `help:"Include uploads"`
-	UploadsLimit     int    `name:"uploads-limit" help:"Maximum number of uploads/comments to scan" default:"50"`
+	IssueID       string `arg:"" name:"issue-id" help:"Issue ID"`
+	Comments      bool   `help:"Include comments"`
+	CommentsLimit int    `name:"comments-limit" help:"Maximum number of comments" default:"20"`
+	Uploads       bool   `help:"Include uploads"`
+	UploadsLimit  int    `name:"uploads-limit" help:"Maximum number of uploads/comments to scan" default:"50"`
+	Relations     bool   `help:"Include blocks, blocked-by, related, and duplicate relations"`
 }
 
 type IssueCreateCmd struct {
-	Team        string `help:"Team key or ID"`
-	Title       string `help:"Issue title"`
-	Description string `help:"Issue description or '-' for stdin"`
-	Assignee    string `help:"Assignee (me, id, or email)"`
-	State       string `help:"Workflow state name or ID"`
-	Priority    int    `help:"Priority (0-4)" default:"-1"`
-	Project     string `help:"Project name or ID"`
-	Cycle       string `help:"Cycle ID or 'current'"`
-	Labels      string `help:"Comma-separated label names or IDs"`
-	Blocks      string `help:"Comma-separated issue IDs or keys this issue blocks"`
-	BlockedBy   string `name:"blocked-by" help:"Comma-separated issue IDs or keys blocking this issue"`
+	Team        string            `help:"Team key or ID"`
+	Title       string            `help:"Issue title"`
+	Description string            `help:"Issue description or '-' for stdin"`
+	Assignee    string            `help:"Assignee (me, id, or email)"`
+	State       string            `help:"Workflow state name or ID"`
+	Priority    string            `help:"Priority (none, urgent, high, medium, low, or 0-4)"`
+	Project     string            `help:"Project name or ID"`
+	Cycle       string            `help:"Cycle ID or 'current'"`
+	Labels      string            `help:"Comma-separated label names or IDs"`
+	Estimate    string            `help:"Estimate (points)"`
+	Due         string            `help:"Due date (YYYY-MM-DD)"`
+	Blocks      string            `help:"Comma-separated issue IDs or keys this issue blocks"`
+	BlockedBy   string            `name:"blocked-by" help:"Comma-separated issue IDs or keys blocking this issue"`
+	Parent      string            `help:"Parent issue ID or key"`
+	Edit        bool              `help:"Write the issue in $VISUAL/$EDITOR"`
+	Template    string            `help:"Template name (local file or Linear template)"`
+	Vars        map[string]string `name:"var" help:"Template value (name=value)"`
+	Attach      []string          `help:"File to upload into the description (repeatable)" sep:"none" type:"path"`
 }
 
 type IssueUpdateCmd struct {
-	IssueID         string `arg:"" name:"issue-id" help:"Issue ID"`
-	Team            string `help:"Team key or ID"`
-	Title           string `help:"Issue title"`
-	Description     string `help:"Issue description or '-' for stdin"`
-	Assignee        string `help:"Assignee (me, id, or email)"`
-	State           string `help:"Workflow state name or ID"`
-	Priority        int    `help:"Priority (0-4)" default:"-1"`
-	Project         string `help:"Project name or ID"`
-	Cycle           string `help:"Cycle ID or 'current'"`
-	Labels          string `help:"Comma-separated label names or IDs"`
-	Blocks          string `help:"Comma-separated issue IDs or keys this issue blocks"`
-	BlockedBy       string `name:"blocked-by" help:"Comma-separated issue IDs or keys blocking this issue"`
-	RemoveBlocks    string `name:"remove-blocks" help:"Comma-separated issue IDs or keys to remove from blocks"`
-	RemoveBlockedBy string `name:"remove-blocked-by" help:"Comma-separated issue IDs or keys to remove from blocked-by"`
+	IssueID          string `arg:"" optional:"" name:"issue-id" help:"Issue ID"`
+	IssueUpdateFlags `embed:""`
+	Edit             bool `help:"Edit the issue in $VISUAL/$EDITOR"`
+	FromStdin        bool `name:"from-stdin" help:"Read issue IDs (one per line, or NDJSON from 'issue list --format ndjson') from stdin"`
+	Concurrency      int  `help:"Number of concurrent updates with --from-stdin" default:"4"`
+}
+
+type IssueUpdateFlags struct {
+	Team             string `help:"Team key or ID"`
+	Title            string `help:"Issue title"`
+	Description      string `help:"Issue description or '-' for stdin" xor:"description"`
+	ClearDescription bool   `name:"clear-description" help:"Remove the description" xor:"description"`
+	Assignee         string `help:"Assignee (me, id, or email)" xor:"assignee"`
+	Unassign         bool   `help:"Remove the assignee" xor:"assignee"`
+	State            string `help:"Workflow state name or ID"`
+	Priority         string `help:"Priority (none, urgent, high, medium, low, or 0-4)"`
+	Project          string `help:"Project name or ID" xor:"project"`
+	NoProject        bool   `name:"no-project" help:"Remove the issue from its project" xor:"project"`
+	Cycle            string `help:"Cycle ID or 'current'" xor:"cycle"`
+	NoCycle          bool   `name:"no-cycle" help:"Remove the issue from its cycle" xor:"cycle"`
+	Labels           string `help:"Comma-separated label names or IDs" xor:"labels"`
+	ClearLabels      bool   `name:"clear-labels" help:"Remove all labels" xor:"labels"`
+	AddLabels        string `name:"add-label" help:"Comma-separated label names or IDs to add"`
+	RemoveLabels     string `name:"remove-label" help:"Comma-separated label names or IDs to remove"`
+	Estimate         string `help:"Estimate (points)" xor:"estimate"`
+	ClearEstimate    bool   `name:"clear-estimate" help:"Remove the estimate" xor:"estimate"`
+	Due              string `help:"Due date (YYYY-MM-DD)" xor:"due"`
+	ClearDue         bool   `name:"clear-due" help:"Remove the due date" xor:"due"`
+	Parent           string `help:"Parent issue ID or key" xor:"parent"`
+	NoParent         bool   `name:"no-parent" help:"Remove the parent issue" xor:"parent"`
+	Blocks           string `help:"Comma-separated issue IDs or keys this issue blocks"`
+	BlockedBy        string `name:"blocked-by" help:"Comma-separated issue IDs or keys blocking this issue"`
+	RemoveBlocks     string `name:"remove-blocks" help:"Comma-separated issue IDs or keys to remove from blocks"`
+	RemoveBlockedBy  string `name:"remove-blocked-by" help:"Comma-separated issue IDs or keys to remove from blocked-by"`
 }
 
 type IssueCloseCmd struct {
@@ -83,8 +137,10 @@ type IssueReopenCmd struct {
 }
 
 type IssueCommentCmd struct {
-	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
-	Body    string `help:"Comment body or '-' for stdin"`
+	IssueID string   `arg:"" name:"issue-id" help:"Issue ID"`
+	Body    string   `help:"Comment body or '-' for stdin"`
+	Edit    bool     `help:"Write the comment in $VISUAL/$EDITOR"`
+	Attach  []string `help:"File to upload into the comment (repeatable)" sep:"none" type:"path"`
 }
 
 func (c *IssueListCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
@@ -93,46 +149,124 @@ func (c *IssueListCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
 		return exitError(3, err)
 	}
 
+	filter, err := resolveIssueFilter(ctx, client, issueFilterOptions{
+		Team:            c.Team,
+		Assignee:        c.Assignee,
+		Unassigned:      c.Unassigned,
+		State:           c.State,
+		Labels:          c.Labels,
+		Project:         c.Project,
+		Cycle:           c.Cycle,
+		Search:          c.Search,
+		Priority:        c.Priority,
+		Parent:          c.Parent,
+		Subscriber:      c.Subscriber,
+		IncludeArchived: c.Archived,
+	})
+	if err != nil {
+		return err
+	}
+
+	var page linear.IssuePage
+	if c.View != "" {
+		viewID, resolveErr := client.ResolveCustomViewID(ctx, c.View)
+		if resolveErr != nil {
+			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		page, err = client.CustomViewIssues(ctx, viewID, filter, c.Limit, c.After)
+	} else {
+		page, err = client.Issues(ctx, filter, c.Limit, c.After)
+	}
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+
+	if c.Format == "ndjson" {
+		enc := json.NewEncoder(cmdCtx.deps.Out)
+		for _, issue := range page.Nodes {
+			if err := enc.Encode(issue); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(page)
+	}
+	rows := make([][]string, 0, len(page.Nodes))
+	for _, issue := range page.Nodes {
+		rows = append(rows, []string{issue.Identifier, issue.Title, issue.State, issue.Priority.String(), issue.Assignee, issue.TeamKey, issue.Cycle})
+	}
+	return out.PrintTable([]string{"ID", "Title", "State", "Priority", "Assignee", "Team", "Cycle"}, rows)
+}
+
+type issueFilterOptions struct {
+	Team            string
+	Assignee        string
+	Unassigned      bool
+	State           string
+	Labels          string
+	Project         string
+	Cycle           string
+	Search          string
+	Priority        string
+	Parent          string
+	Subscriber      string
+	IncludeArchived bool
+}
+
+func resolveIssueFilter(ctx context.Context, client linear.API, c issueFilterOptions) (linear.IssueFilter, error) {
 	filter := linear.IssueFilter{}
 	if c.Team != "" {
 		teamID, resolveErr := client.ResolveTeamID(ctx, c.Team)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueFilter{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
 		filter.TeamID = teamID
 	}
 	if c.Assignee != "" {
 		assigneeID, resolveErr := client.ResolveUserID(ctx, c.Assignee)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueFilter{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
 		filter.AssigneeID = assigneeID
 	}
+	if c.Unassigned {
+		filter.Unassigned = true
+	}
+	if c.Subscriber != "" {
+		subscriberID, resolveErr := client.ResolveUserID(ctx, c.Subscriber)
+		if resolveErr != nil {
+			return linear.IssueFilter{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		filter.SubscriberID = subscriberID
+	}
 	if c.State != "" {
 		if filter.TeamID == "" && looksLikeID(c.State) {
 			filter.StateID = c.State
 		} else {
 			if filter.TeamID == "" {
-				return exitError(2, errors.New("--state requires --team to resolve state name"))
+				return linear.IssueFilter{}, exitError(2, errors.New("--state requires --team to resolve state name"))
 			}
 			stateID, resolveErr := client.ResolveStateID(ctx, filter.TeamID, c.State)
 			if resolveErr != nil {
-				return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+				return linear.IssueFilter{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 			}
 			filter.StateID = stateID
 		}
 	}
 	if c.Labels != "" {
-		labels, resolveErr := client.ResolveLabelIDs(ctx, splitComma(c.Labels))
+		labels, resolveErr := client.ResolveLabelIDs(ctx, filter.TeamID, splitComma(c.Labels))
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueFilter{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
 		filter.LabelIDs = labels
 	}
 	if c.Project != "" {
 		projectID, resolveErr := client.ResolveProjectID(ctx, c.Project)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueFilter{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
 		filter.ProjectID = projectID
 	}
@@ -141,11 +275,11 @@ func (c *IssueListCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
 			filter.CycleID = c.Cycle
 		} else {
 			if filter.TeamID == "" {
-				return exitError(2, errors.New("--cycle requires --team to resolve 'current'"))
+				return linear.IssueFilter{}, exitError(2, errors.New("--cycle requires --team to resolve 'current'"))
 			}
 			cycleID, resolveErr := client.ResolveCycleID(ctx, filter.TeamID, c.Cycle)
 			if resolveErr != nil {
-				return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+				return linear.IssueFilter{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 			}
 			filter.CycleID = cycleID
 		}
@@ -153,24 +287,22 @@ func (c *IssueListCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
 	if c.Search != "" {
 		filter.Search = c.Search
 	}
-	if c.Priority >= 0 {
-		filter.Priority = &c.Priority
-	}
-
-	page, err := client.Issues(ctx, filter, c.Limit, c.After)
-	if err != nil {
-		return exitError(mapErrorToExitCode(err), err)
-	}
-
-	out := outputFor(cmdCtx)
-	if out.JSON {
-		return out.PrintJSON(page)
+	filter.IncludeArchived = c.IncludeArchived
+	if c.Parent != "" {
+		parentID, resolveErr := client.ResolveIssueID(ctx, c.Parent)
+		if resolveErr != nil {
+			return linear.IssueFilter{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		filter.ParentID = parentID
 	}
-	rows := make([][]string, 0, len(page.Nodes))
-	for _, issue := range page.Nodes {
-		rows = append(rows, []string{issue.Identifier, issue.Title, issue.State, issue.Assignee, issue.TeamKey, issue.Cycle})
+	if c.Priority != "" {
+		priorities, parseErr := linear.ParsePriorityRange(c.Priority)
+		if parseErr != nil {
+			return linear.IssueFilter{}, exitError(2, parseErr)
+		}
+		filter.Priorities = priorities
 	}
-	return out.PrintTable([]string{"ID", "Title", "State", "Assignee", "Team", "Cycle"}, rows)
+	return filter, nil
 }
 
 func (c *IssueViewCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
@@ -190,6 +322,13 @@ func (c *IssueViewCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
 		}
 		issue.Comments = comments
 	}
+	if c.Relations {
+		relations, err := client.IssueRelations(ctx, issue.ID, 200)
+		if err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		issue.Relations = relations.Links()
+	}
 	if c.Uploads {
 		uploads, err := client.IssueUploads(ctx, issue.ID, c.UploadsLimit)
 		if err != nil {
@@ -209,7 +348,7 @@ func (c *IssueViewCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
 		issue.TeamKey,
 		issue.Cycle,
 		issue.Project,
-		fmt.Sprintf("%d", issue.Priority),
+		issue.Priority.String(),
 	}}
 	if err := out.PrintTable([]string{"ID", "Title", "State", "Assignee", "Team", "Cycle", "Project", "Priority"}, rows); err != nil {
 		return err
@@ -220,9 +359,43 @@ func (c *IssueViewCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
 	if len(issue.Labels) > 0 {
 		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Labels: %s\n", strings.Join(issue.Labels, ", "))
 	}
+	if len(issue.Reactions) > 0 {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Reactions: %s\n", summarizeReactions(issue.Reactions))
+	}
+	if issue.Parent != "" {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Parent: %s\n", issue.Parent)
+	}
+	if len(issue.SubIssues) > 0 {
+		done := 0
+		for _, child := range issue.SubIssues {
+			if child.StateType == "completed" {
+				done++
+			}
+		}
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Sub-issues: %d/%d done (%d%%)\n", done, len(issue.SubIssues), done*100/len(issue.SubIssues))
+	}
+	if issue.Estimate != nil {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Estimate: %s\n", formatEstimate(*issue.Estimate))
+	}
+	if issue.DueDate != nil {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Due: %s\n", issue.DueDate)
+	}
+	if issue.BranchName != "" {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Branch: %s\n", issue.BranchName)
+	}
 	if issue.Description != "" {
 		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "\nDescription:\n%s\n", issue.Description)
 	}
+	if c.Relations {
+		if len(issue.Relations) == 0 {
+			_, _ = fmt.Fprintln(cmdCtx.deps.Out, "\nRelations: none")
+		} else {
+			_, _ = fmt.Fprintln(cmdCtx.deps.Out, "\nRelations:")
+			for _, link := range issue.Relations {
+				_, _ = fmt.Fprintf(cmdCtx.deps.Out, "- %s %s %s [%s]\n", relationLabel(link.Type), link.Issue.Identifier, link.Issue.Title, link.Issue.State)
+			}
+		}
+	}
 	if c.Uploads {
 		if len(issue.Uploads) == 0 {
 			_, _ = fmt.Fprintln(cmdCtx.deps.Out, "\nUploads: none")
@@ -247,8 +420,14 @@ func (c *IssueViewCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
 			}
 		}
 	}
-	if issue.CreatedAt != "" || issue.UpdatedAt != "" {
-		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "\nCreated: %s\nUpdated: %s\n", issue.CreatedAt, issue.UpdatedAt)
+	if !issue.CreatedAt.IsZero() || !issue.UpdatedAt.IsZero() {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "\nCreated: %s\nUpdated: %s\n", formatTime(issue.CreatedAt), formatTime(issue.UpdatedAt))
+	}
+	if issue.CompletedAt != nil {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Completed: %s\n", formatTime(*issue.CompletedAt))
+	}
+	if issue.CanceledAt != nil {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Canceled: %s\n", formatTime(*issue.CanceledAt))
 	}
 	if c.Comments && len(issue.Comments) > 0 {
 		_, _ = fmt.Fprintln(cmdCtx.deps.Out, "\nComments:")
@@ -266,99 +445,310 @@ func (c *IssueViewCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
 			} else {
 				_, _ = fmt.Fprintf(cmdCtx.deps.Out, "- %s: %s\n", comment.CreatedAt, body)
 			}
+			if len(comment.Reactions) > 0 {
+				_, _ = fmt.Fprintf(cmdCtx.deps.Out, "  Reactions: %s\n", summarizeReactions(comment.Reactions))
+			}
 		}
 	}
 	return nil
 }
 
 func (c *IssueCreateCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
-	if c.Team == "" {
+	interactive := cmdCtx.interactive()
+	var prompt *prompter
+	promptFor := func() *prompter {
+		if prompt == nil {
+			prompt = newPrompter(cmdCtx)
+		}
+		return prompt
+	}
+
+	// Prompts read the same stdin, so it can't also carry the description.
+	if c.Description == "-" && interactive && (c.Team == "" || c.Title == "" || c.Template != "") {
+		return exitError(2, errors.New("--description - can't be combined with interactive prompts; pass --team and --title, or --no-input"))
+	}
+	if c.Edit && c.Description == "-" {
+		return exitError(2, errors.New("--edit cannot read the description from stdin; pass it as --description text"))
+	}
+	description, err := readOptionalBody(c.Description, cmdCtx.deps.In)
+	if err != nil {
+		return exitError(1, err)
+	}
+	files, err := prepareUploads(c.Attach)
+	if err != nil {
+		return err
+	}
+	var client linear.API
+	if c.Template != "" {
+		client, err = cmdCtx.apiClient()
+		if err != nil {
+			return exitError(3, err)
+		}
+		templateBody, templateErr := c.applyTemplate(ctx, cmdCtx, client, promptFor)
+		if templateErr != nil {
+			return templateErr
+		}
+		if description == "" {
+			description = templateBody
+		}
+	}
+	if c.Team == "" && !interactive {
 		return exitError(2, errors.New("--team is required"))
 	}
-	if c.Title == "" {
+	if c.Edit {
+		prefilled := issueDraft{
+			Title:    c.Title,
+			State:    c.State,
+			Assignee: c.Assignee,
+			Labels:   splitComma(c.Labels),
+			Priority: c.Priority,
+			Body:     description,
+		}
+		// Only an empty buffer aborts: saving the pre-filled flag values as
+		// they are still creates the issue.
+		draft, editErr := editIssueDraft(cmdCtx, prefilled, issueDraft{})
+		if editErr != nil {
+			return editErr
+		}
+		c.Title = draft.Title
+		c.State = draft.State
+		c.Assignee = draft.Assignee
+		c.Labels = strings.Join(draft.Labels, ",")
+		c.Priority = draft.Priority
+		description = draft.Body
+	}
+	if c.Title == "" && !interactive {
 		return exitError(2, errors.New("--title is required"))
 	}
 
-	client, err := cmdCtx.apiClient()
-	if err != nil {
-		return exitError(3, err)
+	if client == nil {
+		client, err = cmdCtx.apiClient()
+		if err != nil {
+			return exitError(3, err)
+		}
+	}
+
+	promptRest := c.Team == "" || c.Title == ""
+	if c.Team == "" {
+		if err := c.promptTeam(ctx, client, promptFor()); err != nil {
+			return err
+		}
 	}
 
 	teamID, err := client.ResolveTeamID(ctx, c.Team)
 	if err != nil {
 		return exitError(mapErrorToExitCode(err), err)
 	}
+	if promptRest {
+		if err := c.promptMissing(ctx, client, promptFor(), teamID); err != nil {
+			return err
+		}
+	}
 
-	input := map[string]any{
-		"teamId": teamID,
-		"title":  c.Title,
+	if len(files) > 0 {
+		uploaded, err := uploadFiles(ctx, client, files)
+		if err != nil {
+			return err
+		}
+		description = appendMarkdown(description, uploadMarkdown(uploaded))
 	}
 
-	description, err := readOptionalBody(c.Description, cmdCtx.deps.In)
+	issue, err := c.create(ctx, client, teamID, description)
 	if err != nil {
-		return exitError(1, err)
+		return err
 	}
-	if description != "" {
-		input["description"] = description
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(issue)
+	}
+	rows := [][]string{{issue.Identifier, issue.Title, issue.URL}}
+	return out.PrintTable([]string{"ID", "Title", "URL"}, rows)
+}
+
+func (c *IssueCreateCmd) create(ctx context.Context, client linear.API, teamID, description string) (linear.IssueSummary, error) {
+	input := linear.IssueCreateInput{
+		TeamID:      teamID,
+		Title:       c.Title,
+		Description: description,
 	}
 
 	if c.Assignee != "" {
 		assigneeID, resolveErr := client.ResolveUserID(ctx, c.Assignee)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		input["assigneeId"] = assigneeID
+		input.AssigneeID = assigneeID
 	}
 	if c.State != "" {
 		stateID, resolveErr := client.ResolveStateID(ctx, teamID, c.State)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		input["stateId"] = stateID
+		input.StateID = stateID
 	}
-	if c.Priority >= 0 {
-		input["priority"] = c.Priority
+	if c.Priority != "" {
+		priority, parseErr := linear.ParsePriority(c.Priority)
+		if parseErr != nil {
+			return linear.IssueSummary{}, exitError(2, parseErr)
+		}
+		input.Priority = &priority
 	}
 	if c.Project != "" {
 		projectID, resolveErr := client.ResolveProjectID(ctx, c.Project)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		input["projectId"] = projectID
+		input.ProjectID = projectID
 	}
 	if c.Cycle != "" {
 		cycleID, resolveErr := client.ResolveCycleID(ctx, teamID, c.Cycle)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		input["cycleId"] = cycleID
+		input.CycleID = cycleID
+	}
+	if c.Parent != "" {
+		parentID, resolveErr := client.ResolveIssueID(ctx, c.Parent)
+		if resolveErr != nil {
+			return linear.IssueSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		input.ParentID = parentID
 	}
 	if c.Labels != "" {
-		labelIDs, resolveErr := client.ResolveLabelIDs(ctx, splitComma(c.Labels))
+		labelIDs, resolveErr := client.ResolveLabelIDs(ctx, teamID, splitComma(c.Labels))
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		input["labelIds"] = labelIDs
+		input.LabelIDs = labelIDs
+	}
+	if c.Estimate != "" {
+		estimate, parseErr := parseEstimate(c.Estimate)
+		if parseErr != nil {
+			return linear.IssueSummary{}, exitError(2, parseErr)
+		}
+		input.Estimate = &estimate
+	}
+	if c.Due != "" {
+		due, parseErr := linear.ParseDate(c.Due)
+		if parseErr != nil {
+			return linear.IssueSummary{}, exitError(2, parseErr)
+		}
+		input.DueDate = &due
+	}
+	if err := input.Validate(); err != nil {
+		return linear.IssueSummary{}, exitError(2, err)
 	}
 
 	issue, err := client.IssueCreate(ctx, input)
 	if err != nil {
-		return exitError(mapErrorToExitCode(err), err)
+		return linear.IssueSummary{}, exitError(mapErrorToExitCode(err), err)
 	}
 
 	if err := applyIssueRelations(ctx, client, issue.ID, issueRelationFlags{
 		Blocks:    c.Blocks,
 		BlockedBy: c.BlockedBy,
 	}, false); err != nil {
+		return linear.IssueSummary{}, exitError(mapErrorToExitCode(err), err)
+	}
+	return issue, nil
+}
+
+func (c *IssueCreateCmd) promptTeam(ctx context.Context, client linear.API, prompt *prompter) error {
+	teams, err := client.Teams(ctx)
+	if err != nil {
 		return exitError(mapErrorToExitCode(err), err)
 	}
+	choices := make([]promptChoice, 0, len(teams))
+	for _, team := range teams {
+		choices = append(choices, promptChoice{Label: team.Key + " " + team.Name, Value: team.Key})
+	}
+	c.Team, err = prompt.pick("Team", choices, false)
+	if err != nil {
+		return exitError(1, err)
+	}
+	return nil
+}
 
-	out := outputFor(cmdCtx)
-	if out.JSON {
-		return out.PrintJSON(issue)
+func (c *IssueCreateCmd) promptMissing(ctx context.Context, client linear.API, prompt *prompter, teamID string) error {
+	var err error
+	if c.Title == "" {
+		if c.Title, err = prompt.text("Title"); err != nil {
+			return exitError(1, err)
+		}
 	}
-	rows := [][]string{{issue.Identifier, issue.Title, issue.URL}}
-	return out.PrintTable([]string{"ID", "Title", "URL"}, rows)
+	if c.State == "" {
+		states, listErr := client.WorkflowStates(ctx, teamID)
+		if listErr != nil {
+			return exitError(mapErrorToExitCode(listErr), listErr)
+		}
+		choices := make([]promptChoice, 0, len(states))
+		for _, state := range states {
+			choices = append(choices, promptChoice{Label: state.Name, Value: state.ID})
+		}
+		if c.State, err = prompt.pick("State", choices, true); err != nil {
+			return exitError(1, err)
+		}
+	}
+	if c.Assignee == "" {
+		users, listErr := client.Users(ctx)
+		if listErr != nil {
+			return exitError(mapErrorToExitCode(listErr), listErr)
+		}
+		choices := make([]promptChoice, 0, len(users))
+		for _, user := range users {
+			choices = append(choices, promptChoice{Label: user.Name + " <" + user.Email + ">", Value: user.ID})
+		}
+		if c.Assignee, err = prompt.pick("Assignee", choices, true); err != nil {
+			return exitError(1, err)
+		}
+	}
+	if c.Labels == "" {
+		labels, listErr := client.IssueLabels(ctx, teamID)
+		if listErr != nil {
+			return exitError(mapErrorToExitCode(listErr), listErr)
+		}
+		choices := make([]promptChoice, 0, len(labels))
+		for _, label := range labels {
+			choices = append(choices, promptChoice{Label: label.Name, Value: label.ID})
+		}
+		picked, pickErr := prompt.pickMany("Label", choices)
+		if pickErr != nil {
+			return exitError(1, pickErr)
+		}
+		c.Labels = strings.Join(picked, ",")
+	}
+	if c.Project == "" {
+		projects, listErr := client.Projects(ctx)
+		if listErr != nil {
+			return exitError(mapErrorToExitCode(listErr), listErr)
+		}
+		choices := make([]promptChoice, 0, len(projects))
+		for _, project := range projects {
+			choices = append(choices, promptChoice{Label: project.Name, Value: project.ID})
+		}
+		if c.Project, err = prompt.pick("Project", choices, true); err != nil {
+			return exitError(1, err)
+		}
+	}
+	if c.Cycle == "" {
+		page, listErr := client.Cycles(ctx, teamID, false, 50, "")
+		if listErr != nil {
+			return exitError(mapErrorToExitCode(listErr), listErr)
+		}
+		choices := make([]promptChoice, 0, len(page.Nodes))
+		for _, cycle := range page.Nodes {
+			label := cycle.Name
+			if label == "" {
+				label = "Cycle " + cycle.Number
+			}
+			choices = append(choices, promptChoice{Label: label, Value: cycle.ID})
+		}
+		if c.Cycle, err = prompt.pick("Cycle", choices, true); err != nil {
+			return exitError(1, err)
+		}
+	}
+	return nil
 }
 
 func (c *IssueUpdateCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
@@ -366,89 +756,255 @@ func (c *IssueUpdateCmd) Run(ctx context.Context, cmdCtx *commandContext) error
 	if err != nil {
 		return exitError(3, err)
 	}
+	if c.FromStdin {
+		return c.runFromStdin(ctx, cmdCtx, client)
+	}
+	if c.IssueID == "" {
+		return exitError(2, errors.New("issue ID is required (or pass --from-stdin)"))
+	}
 	issueID, err := client.ResolveIssueID(ctx, c.IssueID)
 	if err != nil {
 		return exitError(mapErrorToExitCode(err), err)
 	}
+	if err := c.check(); err != nil {
+		return err
+	}
 
-	input := map[string]any{"id": issueID}
-	teamID := ""
-	if c.Team != "" {
-		teamID, err = client.ResolveTeamID(ctx, c.Team)
+	var current *linear.IssueDetail
+	var description string
+	if c.Edit {
+		if c.AddLabels != "" || c.RemoveLabels != "" {
+			return exitError(2, errors.New("--edit cannot be combined with --add-label or --remove-label"))
+		}
+		if c.Description == "-" {
+			return exitError(2, errors.New("--edit cannot read the description from stdin; pass it as --description text"))
+		}
+		issue, resolveErr := client.Issue(ctx, issueID)
+		if resolveErr != nil {
+			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		current = &issue
+		description, err = c.applyEdit(cmdCtx, issue)
 		if err != nil {
-			return exitError(mapErrorToExitCode(err), err)
+			return err
 		}
+	} else {
+		description, err = readOptionalBody(c.Description, cmdCtx.deps.In)
+		if err != nil {
+			return exitError(1, err)
+		}
+	}
+
+	issue, labelChanges, err := c.apply(ctx, client, issueID, description, current)
+	if err != nil {
+		return err
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(issue)
+	}
+	rows := [][]string{{issue.Identifier, issue.Title, issue.URL}}
+	if err := out.PrintTable([]string{"ID", "Title", "URL"}, rows); err != nil {
+		return err
 	}
+	if c.AddLabels != "" || c.RemoveLabels != "" {
+		labelChanges.print(cmdCtx.deps.Out)
+	}
+	return nil
+}
 
+func (c *IssueUpdateFlags) check() error {
+	if (c.AddLabels != "" || c.RemoveLabels != "") && (c.Labels != "" || c.ClearLabels) {
+		return exitError(2, errors.New("--add-label and --remove-label cannot be combined with --labels or --clear-labels"))
+	}
+	return nil
+}
+
+// localInput builds the part of the update that needs no lookups: literal
+// values, parsed priority/estimate/due, and cleared fields.
+func (c *IssueUpdateFlags) localInput(description string) (linear.IssueUpdateInput, error) {
+	input := linear.IssueUpdateInput{}
 	if c.Title != "" {
-		input["title"] = c.Title
+		input.Title = linear.Set(c.Title)
+	}
+	if description != "" {
+		input.Description = linear.Set(description)
+	}
+	if c.ClearDescription {
+		input.Description = linear.Null[string]()
+	}
+	if c.Unassign {
+		input.AssigneeID = linear.Null[string]()
+	}
+	if c.Priority != "" {
+		priority, err := linear.ParsePriority(c.Priority)
+		if err != nil {
+			return input, err
+		}
+		input.Priority = linear.Set(priority)
+	}
+	if c.NoProject {
+		input.ProjectID = linear.Null[string]()
+	}
+	if c.NoCycle {
+		input.CycleID = linear.Null[string]()
+	}
+	if c.ClearLabels {
+		input.LabelIDs = linear.Null[[]string]()
+	}
+	if c.Estimate != "" {
+		estimate, err := parseEstimate(c.Estimate)
+		if err != nil {
+			return input, err
+		}
+		input.Estimate = linear.Set(estimate)
+	}
+	if c.ClearEstimate {
+		input.Estimate = linear.Null[float64]()
+	}
+	if c.Due != "" {
+		due, err := linear.ParseDate(c.Due)
+		if err != nil {
+			return input, err
+		}
+		input.DueDate = linear.Set(due)
 	}
+	if c.ClearDue {
+		input.DueDate = linear.Null[linear.Date]()
+	}
+	if c.NoParent {
+		input.ParentID = linear.Null[string]()
+	}
+	return input, nil
+}
 
-	description, err := readOptionalBody(c.Description, cmdCtx.deps.In)
+// hasLookupChanges reports whether any flag that is resolved against the API,
+// or applied as a relation, was passed.
+func (c *IssueUpdateFlags) hasLookupChanges() bool {
+	for _, value := range []string{c.Assignee, c.State, c.Project, c.Cycle, c.Labels, c.AddLabels, c.RemoveLabels, c.Parent, c.Blocks, c.BlockedBy, c.RemoveBlocks, c.RemoveBlockedBy} {
+		if value != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *IssueUpdateFlags) apply(ctx context.Context, client linear.API, issueID, description string, current *linear.IssueDetail) (linear.IssueSummary, labelChangeSummary, error) {
+	input, err := c.localInput(description)
 	if err != nil {
-		return exitError(1, err)
+		return linear.IssueSummary{}, labelChangeSummary{}, exitError(2, err)
 	}
-	if description != "" {
-		input["description"] = description
+	teamID := ""
+	if c.Team != "" {
+		teamID, err = client.ResolveTeamID(ctx, c.Team)
+		if err != nil {
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(err), err)
+		}
+	}
+	currentIssue := func() (linear.IssueDetail, error) {
+		if current == nil {
+			issue, err := client.Issue(ctx, issueID)
+			if err != nil {
+				return linear.IssueDetail{}, err
+			}
+			current = &issue
+		}
+		return *current, nil
+	}
+	issueTeamID := func() (string, error) {
+		if teamID != "" {
+			return teamID, nil
+		}
+		issue, err := currentIssue()
+		if err != nil {
+			return "", err
+		}
+		return issue.TeamID, nil
 	}
 
 	if c.Assignee != "" {
 		assigneeID, resolveErr := client.ResolveUserID(ctx, c.Assignee)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		input["assigneeId"] = assigneeID
+		input.AssigneeID = linear.Set(assigneeID)
 	}
 
 	if c.State != "" {
-		if teamID == "" {
-			issueResp, resolveErr := client.Issue(ctx, c.IssueID)
-			if resolveErr != nil {
-				return exitError(mapErrorToExitCode(resolveErr), resolveErr)
-			}
-			teamID = issueResp.TeamID
+		stateTeamID, resolveErr := issueTeamID()
+		if resolveErr != nil {
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		stateID, resolveErr := client.ResolveStateID(ctx, teamID, c.State)
+		stateID, resolveErr := client.ResolveStateID(ctx, stateTeamID, c.State)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		input["stateId"] = stateID
-	}
-	if c.Priority >= 0 {
-		input["priority"] = c.Priority
+		input.StateID = linear.Set(stateID)
 	}
 	if c.Project != "" {
 		projectID, resolveErr := client.ResolveProjectID(ctx, c.Project)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		input["projectId"] = projectID
+		input.ProjectID = linear.Set(projectID)
 	}
 	if c.Cycle != "" {
-		if teamID == "" {
-			issueResp, resolveErr := client.Issue(ctx, c.IssueID)
-			if resolveErr != nil {
-				return exitError(mapErrorToExitCode(resolveErr), resolveErr)
-			}
-			teamID = issueResp.TeamID
+		cycleTeamID, resolveErr := issueTeamID()
+		if resolveErr != nil {
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		cycleID, resolveErr := client.ResolveCycleID(ctx, teamID, c.Cycle)
+		cycleID, resolveErr := client.ResolveCycleID(ctx, cycleTeamID, c.Cycle)
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
 		}
-		input["cycleId"] = cycleID
+		input.CycleID = linear.Set(cycleID)
 	}
 	if c.Labels != "" {
-		labelIDs, resolveErr := client.ResolveLabelIDs(ctx, splitComma(c.Labels))
+		labelTeamID, resolveErr := issueTeamID()
 		if resolveErr != nil {
-			return exitError(mapErrorToExitCode(resolveErr), resolveErr)
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		labelIDs, resolveErr := client.ResolveLabelIDs(ctx, labelTeamID, splitComma(c.Labels))
+		if resolveErr != nil {
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		input.LabelIDs = linear.Set(labelIDs)
+	}
+	var labelChanges labelChangeSummary
+	if c.AddLabels != "" || c.RemoveLabels != "" {
+		issue, resolveErr := currentIssue()
+		if resolveErr != nil {
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		labelTeamID, resolveErr := issueTeamID()
+		if resolveErr != nil {
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		labelChanges, resolveErr = resolveLabelChanges(ctx, client, labelTeamID, issue, splitComma(c.AddLabels), splitComma(c.RemoveLabels))
+		if resolveErr != nil {
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		input.AddedLabelIDs = labelChanges.addedIDs
+		input.RemovedLabelIDs = labelChanges.removedIDs
+	}
+	if c.Parent != "" {
+		parentID, resolveErr := client.ResolveIssueID(ctx, c.Parent)
+		if resolveErr != nil {
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(resolveErr), resolveErr)
+		}
+		if parentID == issueID {
+			return linear.IssueSummary{}, labelChangeSummary{}, exitError(2, errors.New("an issue cannot be its own parent"))
 		}
-		input["labelIds"] = labelIDs
+		input.ParentID = linear.Set(parentID)
+	}
+	if err := input.Validate(); err != nil {
+		return linear.IssueSummary{}, labelChangeSummary{}, exitError(2, err)
 	}
 
-	issue, err := client.IssueUpdate(ctx, input)
+	issue, err := client.IssueUpdate(ctx, issueID, input)
 	if err != nil {
-		return exitError(mapErrorToExitCode(err), err)
+		return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(err), err)
 	}
 
 	if err := applyIssueRelations(ctx, client, issueID, issueRelationFlags{
@@ -457,15 +1013,149 @@ func (c *IssueUpdateCmd) Run(ctx context.Context, cmdCtx *commandContext) error
 		RemoveBlocks:    c.RemoveBlocks,
 		RemoveBlockedBy: c.RemoveBlockedBy,
 	}, true); err != nil {
-		return exitError(mapErrorToExitCode(err), err)
+		return linear.IssueSummary{}, labelChangeSummary{}, exitError(mapErrorToExitCode(err), err)
 	}
+	return issue, labelChanges, nil
+}
 
-	out := outputFor(cmdCtx)
-	if out.JSON {
-		return out.PrintJSON(issue)
+func (c *IssueUpdateCmd) applyEdit(cmdCtx *commandContext, issue linear.IssueDetail) (string, error) {
+	original := issueDraft{
+		Title:    issue.Title,
+		State:    issue.State,
+		Assignee: issue.Assignee,
+		Labels:   issue.Labels,
+		Priority: issue.Priority.String(),
+		Body:     strings.TrimSpace(issue.Description),
+	}
+	draft := original
+	if c.Title != "" {
+		draft.Title = c.Title
+	}
+	if c.State != "" {
+		draft.State = c.State
+	}
+	if c.Assignee != "" {
+		draft.Assignee = c.Assignee
+	}
+	if c.Unassign {
+		draft.Assignee = ""
+	}
+	if c.Labels != "" {
+		draft.Labels = splitComma(c.Labels)
+	}
+	if c.ClearLabels {
+		draft.Labels = nil
+	}
+	if c.Priority != "" {
+		draft.Priority = c.Priority
+	}
+	if c.Description != "" {
+		draft.Body = strings.TrimSpace(c.Description)
+	}
+	if c.ClearDescription {
+		draft.Body = ""
+	}
+
+	// Compare against the issue, not the pre-filled draft, so saving without
+	// edits still applies the flag values.
+	edited, err := editIssueDraft(cmdCtx, draft, original)
+	if err != nil {
+		return "", err
+	}
+	if edited.Title == "" {
+		return "", exitError(2, errors.New("issue title cannot be empty"))
+	}
+
+	c.Title = ""
+	if edited.Title != original.Title {
+		c.Title = edited.Title
+	}
+	c.State = ""
+	if edited.State != "" && !strings.EqualFold(edited.State, original.State) {
+		c.State = edited.State
+	}
+	c.Assignee, c.Unassign = "", false
+	if edited.Assignee != original.Assignee {
+		c.Assignee = edited.Assignee
+		c.Unassign = edited.Assignee == ""
+	}
+	c.Labels, c.ClearLabels = "", false
+	if !slices.Equal(edited.Labels, original.Labels) {
+		c.Labels = strings.Join(edited.Labels, ",")
+		c.ClearLabels = len(edited.Labels) == 0
+	}
+	c.Priority = ""
+	if edited.Priority != "" && !strings.EqualFold(edited.Priority, original.Priority) {
+		c.Priority = edited.Priority
+	}
+	c.ClearDescription = false
+	if edited.Body == original.Body {
+		return "", nil
+	}
+	c.ClearDescription = edited.Body == ""
+	return edited.Body, nil
+}
+
+type labelChangeSummary struct {
+	Added      []string
+	Removed    []string
+	Unchanged  []string
+	addedIDs   []string
+	removedIDs []string
+}
+
+func resolveLabelChanges(ctx context.Context, client linear.API, teamID string, issue linear.IssueDetail, add, remove []string) (labelChangeSummary, error) {
+	currentNames := map[string]string{}
+	for i, id := range issue.LabelIDs {
+		if i < len(issue.Labels) {
+			currentNames[id] = issue.Labels[i]
+		}
+	}
+
+	summary := labelChangeSummary{}
+	addIDs, err := client.ResolveLabelIDs(ctx, teamID, add)
+	if err != nil {
+		return summary, err
+	}
+	removeIDs, err := client.ResolveLabelIDs(ctx, teamID, remove)
+	if err != nil {
+		return summary, err
+	}
+	removing := map[string]struct{}{}
+	for i, id := range removeIDs {
+		removing[id] = struct{}{}
+		name, ok := currentNames[id]
+		if !ok {
+			summary.Unchanged = append(summary.Unchanged, remove[i])
+			continue
+		}
+		summary.removedIDs = append(summary.removedIDs, id)
+		summary.Removed = append(summary.Removed, name)
+	}
+	for i, id := range addIDs {
+		if _, ok := removing[id]; ok {
+			return summary, fmt.Errorf("label %q is both added and removed", add[i])
+		}
+		if _, ok := currentNames[id]; ok {
+			summary.Unchanged = append(summary.Unchanged, add[i])
+			continue
+		}
+		summary.addedIDs = append(summary.addedIDs, id)
+		summary.Added = append(summary.Added, add[i])
+	}
+	return summary, nil
+}
+
+func (s labelChangeSummary) print(w io.Writer) {
+	if len(s.Added) > 0 {
+		_, _ = fmt.Fprintf(w, "Labels added: %s\n", strings.Join(s.Added, ", "))
+	}
+	if len(s.Removed) > 0 {
+		_, _ = fmt.Fprintf(w, "Labels removed: %s\n", strings.Join(s.Removed, ", "))
+	}
+	if len(s.Unchanged) > 0 {
+		_, _ = fmt.Fprintf(w, "Labels unchanged: %s\n", strings.Join(s.Unchanged, ", "))
 	}
-	rows := [][]string{{issue.Identifier, issue.Title, issue.URL}}
-	return out.PrintTable([]string{"ID", "Title", "URL"}, rows)
 }
 
 func (c *IssueCloseCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
@@ -499,7 +1189,7 @@ func issueSetState(ctx context.Context, cmdCtx *commandContext, issueRef string,
 	if stateID == "" {
 		return exitError(4, fmt.Errorf("no workflow state of type %s", stateType))
 	}
-	updated, err := client.IssueUpdate(ctx, map[string]any{"id": issue.ID, "stateId": stateID})
+	updated, err := client.IssueUpdate(ctx, issue.ID, linear.IssueUpdateInput{StateID: linear.Set(stateID)})
 	if err != nil {
 		return exitError(mapErrorToExitCode(err), err)
 	}
@@ -512,6 +1202,13 @@ func issueSetState(ctx context.Context, cmdCtx *commandContext, issueRef string,
 }
 
 func (c *IssueCommentCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	if c.Edit && c.Body == "-" {
+		return exitError(2, errors.New("--edit cannot read the body from stdin; pass it as --body text"))
+	}
+	files, err := prepareUploads(c.Attach)
+	if err != nil {
+		return err
+	}
 	client, err := cmdCtx.apiClient()
 	if err != nil {
 		return exitError(3, err)
@@ -524,9 +1221,22 @@ func (c *IssueCommentCmd) Run(ctx context.Context, cmdCtx *commandContext) error
 	if err != nil {
 		return exitError(1, err)
 	}
-	if strings.TrimSpace(text) == "" {
+	if c.Edit {
+		text, err = editText(cmdCtx, "comment-*.md", text)
+		if err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(text) == "" && len(files) == 0 {
 		return exitError(2, errors.New("comment body is required"))
 	}
+	if len(files) > 0 {
+		uploaded, err := uploadFiles(ctx, client, files)
+		if err != nil {
+			return err
+		}
+		text = appendMarkdown(text, uploadMarkdown(uploaded))
+	}
 	commentID, err := client.IssueComment(ctx, issueID, text)
 	if err != nil {
 		return exitError(mapErrorToExitCode(err), err)
@@ -540,10 +1250,14 @@ func (c *IssueCommentCmd) Run(ctx context.Context, cmdCtx *commandContext) error
 }
 
 type issueRelationFlags struct {
-	Blocks          string
-	BlockedBy       string
-	RemoveBlocks    string
-	RemoveBlockedBy string
+	Blocks            string
+	BlockedBy         string
+	Related           string
+	DuplicateOf       string
+	RemoveBlocks      string
+	RemoveBlockedBy   string
+	RemoveRelated     string
+	RemoveDuplicateOf string
 }
 
 func splitComma(input string) []string {
@@ -573,6 +1287,14 @@ func readOptionalBody(flagValue string, r io.Reader) (string, error) {
 	return string(data), nil
 }
 
+func parseEstimate(value string) (float64, error) {
+	estimate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || estimate < 0 {
+		return 0, fmt.Errorf("invalid estimate %q", value)
+	}
+	return estimate, nil
+}
+
 func looksLikeID(value string) bool {
 	if len(value) < 30 {
 		return false
@@ -580,34 +1302,91 @@ func looksLikeID(value string) bool {
 	return strings.Count(value, "-") >= 4
 }
 
-func applyIssueRelations(ctx context.Context, client linear.API, issueID string, flags issueRelationFlags, fetchExisting bool) error {
-	addBlocks := uniqueStrings(splitComma(flags.Blocks))
-	addBlockedBy := uniqueStrings(splitComma(flags.BlockedBy))
-	removeBlocks := uniqueStrings(splitComma(flags.RemoveBlocks))
-	removeBlockedBy := uniqueStrings(splitComma(flags.RemoveBlockedBy))
+type relationKind struct {
+	Type      string
+	Inverse   bool
+	Symmetric bool
+}
 
-	if len(addBlocks) == 0 && len(addBlockedBy) == 0 && len(removeBlocks) == 0 && len(removeBlockedBy) == 0 {
-		return nil
-	}
+var (
+	relationBlocks      = relationKind{Type: linear.RelationBlocks}
+	relationBlockedBy   = relationKind{Type: linear.RelationBlocks, Inverse: true}
+	relationRelated     = relationKind{Type: linear.RelationRelated, Symmetric: true}
+	relationDuplicateOf = relationKind{Type: linear.RelationDuplicate}
+)
 
-	outgoing := map[string]string{}
-	incoming := map[string]string{}
-	if fetchExisting {
-		existing, err := client.IssueRelations(ctx, issueID, 200)
-		if err != nil {
-			return err
-		}
+func (k relationKind) find(existing linear.IssueRelationSet, targetID string) string {
+	if !k.Inverse || k.Symmetric {
 		for _, rel := range existing.Relations {
-			if strings.EqualFold(rel.Type, "blocks") {
-				outgoing[rel.RelatedIssueID] = rel.ID
+			if strings.EqualFold(rel.Type, k.Type) && rel.RelatedIssueID == targetID {
+				return rel.ID
 			}
 		}
+	}
+	if k.Inverse || k.Symmetric {
 		for _, rel := range existing.InverseRelations {
-			if strings.EqualFold(rel.Type, "blocks") {
-				incoming[rel.IssueID] = rel.ID
+			if strings.EqualFold(rel.Type, k.Type) && rel.IssueID == targetID {
+				return rel.ID
 			}
 		}
 	}
+	return ""
+}
+
+func (k relationKind) create(ctx context.Context, client linear.API, issueID, targetID string) error {
+	var err error
+	if k.Inverse {
+		_, err = client.IssueRelationCreate(ctx, targetID, issueID, k.Type)
+	} else {
+		_, err = client.IssueRelationCreate(ctx, issueID, targetID, k.Type)
+	}
+	return err
+}
+
+type relationChange struct {
+	kind   relationKind
+	refs   []string
+	remove bool
+}
+
+func (f issueRelationFlags) changes() []relationChange {
+	changes := []relationChange{
+		{kind: relationBlocks, refs: uniqueStrings(splitComma(f.RemoveBlocks)), remove: true},
+		{kind: relationBlockedBy, refs: uniqueStrings(splitComma(f.RemoveBlockedBy)), remove: true},
+		{kind: relationRelated, refs: uniqueStrings(splitComma(f.RemoveRelated)), remove: true},
+		{kind: relationDuplicateOf, refs: uniqueStrings(splitComma(f.RemoveDuplicateOf)), remove: true},
+		{kind: relationBlocks, refs: uniqueStrings(splitComma(f.Blocks))},
+		{kind: relationBlockedBy, refs: uniqueStrings(splitComma(f.BlockedBy))},
+		{kind: relationRelated, refs: uniqueStrings(splitComma(f.Related))},
+		{kind: relationDuplicateOf, refs: uniqueStrings(splitComma(f.DuplicateOf))},
+	}
+	out := changes[:0]
+	for _, change := range changes {
+		if len(change.refs) > 0 {
+			out = append(out, change)
+		}
+	}
+	return out
+}
+
+func (f issueRelationFlags) isEmpty() bool {
+	return len(f.changes()) == 0
+}
+
+func applyIssueRelations(ctx context.Context, client linear.API, issueID string, flags issueRelationFlags, fetchExisting bool) error {
+	changes := flags.changes()
+	if len(changes) == 0 {
+		return nil
+	}
+
+	existing := linear.IssueRelationSet{}
+	if fetchExisting {
+		var err error
+		existing, err = client.IssueRelations(ctx, issueID, 200)
+		if err != nil {
+			return err
+		}
+	}
 
 	resolveID := func(ref string) (string, error) {
 		id, err := client.ResolveIssueID(ctx, ref)
@@ -620,54 +1399,31 @@ func applyIssueRelations(ctx context.Context, client linear.API, issueID string,
 		return id, nil
 	}
 
-	for _, ref := range removeBlocks {
-		targetID, err := resolveID(ref)
-		if err != nil {
-			return err
-		}
-		if relationID := outgoing[targetID]; relationID != "" {
-			if err := client.IssueRelationDelete(ctx, relationID); err != nil {
+	for _, change := range changes {
+		for _, ref := range change.refs {
+			targetID, err := resolveID(ref)
+			if err != nil {
 				return err
 			}
-		}
-	}
-	for _, ref := range removeBlockedBy {
-		targetID, err := resolveID(ref)
-		if err != nil {
-			return err
-		}
-		if relationID := incoming[targetID]; relationID != "" {
-			if err := client.IssueRelationDelete(ctx, relationID); err != nil {
+			relationID := change.kind.find(existing, targetID)
+			if change.remove {
+				if relationID == "" {
+					continue
+				}
+				if err := client.IssueRelationDelete(ctx, relationID); err != nil {
+					return err
+				}
+				continue
+			}
+			if relationID != "" {
+				continue
+			}
+			if err := change.kind.create(ctx, client, issueID, targetID); err != nil {
 				return err
 			}
 		}
 	}
 
-	for _, ref := range addBlocks {
-		targetID, err := resolveID(ref)
-		if err != nil {
-			return err
-		}
-		if outgoing[targetID] != "" {
-			continue
-		}
-		if _, err := client.IssueRelationCreate(ctx, issueID, targetID, "blocks"); err != nil {
-			return err
-		}
-	}
-	for _, ref := range addBlockedBy {
-		targetID, err := resolveID(ref)
-		if err != nil {
-			return err
-		}
-		if incoming[targetID] != "" {
-			continue
-		}
-		if _, err := client.IssueRelationCreate(ctx, targetID, issueID, "blocks"); err != nil {
-			return err
-		}
-	}
-
 	return nil
 }
 
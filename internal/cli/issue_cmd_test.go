@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func TestIssueUpdateClearFlagsSendNulls(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, _, errOut := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"issue", "update", "ENG-1", "--unassign", "--no-cycle", "--no-project", "--clear-labels", "--clear-due", "--clear-estimate", "--clear-description"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.updates) != 1 {
+		t.Fatalf("expected 1 update, got %d", len(api.updates))
+	}
+	vars := api.updates[0].Input.Variables()
+	for _, key := range []string{"assigneeId", "cycleId", "projectId", "dueDate", "estimate", "description"} {
+		value, ok := vars[key]
+		if !ok || value != nil {
+			t.Fatalf("expected %s to be null, got %v (present %t)", key, value, ok)
+		}
+	}
+	if labels, ok := vars["labelIds"].([]string); !ok || len(labels) != 0 {
+		t.Fatalf("expected empty labelIds, got %v", vars["labelIds"])
+	}
+	if _, ok := vars["title"]; ok {
+		t.Fatalf("expected title to be left unchanged")
+	}
+}
+
+func TestIssueUpdateSetsEstimateAndDue(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, _, errOut := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"issue", "update", "ENG-1", "--estimate", "3", "--due", "2026-03-01", "--priority", "high"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	vars := api.updates[0].Input.Variables()
+	if vars["estimate"] != 3.0 {
+		t.Fatalf("expected estimate 3, got %v", vars["estimate"])
+	}
+	if vars["dueDate"] != "2026-03-01" {
+		t.Fatalf("expected dueDate, got %v", vars["dueDate"])
+	}
+	if vars["priority"] != 2 {
+		t.Fatalf("expected priority 2, got %v", vars["priority"])
+	}
+}
+
+func TestIssueUpdateRejectsConflictingClearFlags(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, _, _ := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"issue", "update", "ENG-1", "--assignee", "me", "--unassign"})
+	if code != 2 {
+		t.Fatalf("expected exit 2, got %d", code)
+	}
+	if len(api.updates) != 0 {
+		t.Fatalf("expected no update to be sent")
+	}
+}
+
+func TestIssueUpdateAddsAndRemovesLabels(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{issues: map[string]linear.IssueDetail{
+		"ENG-1": {
+			ID:         "issue-1",
+			Identifier: "ENG-1",
+			TeamID:     "team-1",
+			Labels:     []string{"bug", "ui"},
+			LabelIDs:   []string{"label-bug", "label-ui"},
+		},
+	}}
+	deps, out, errOut := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"issue", "update", "ENG-1", "--add-label", "bug,backend", "--remove-label", "ui"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	vars := api.updates[0].Input.Variables()
+	if added, ok := vars["addedLabelIds"].([]string); !ok || len(added) != 1 || added[0] != "label-backend" {
+		t.Fatalf("unexpected addedLabelIds: %v", vars["addedLabelIds"])
+	}
+	if removed, ok := vars["removedLabelIds"].([]string); !ok || len(removed) != 1 || removed[0] != "label-ui" {
+		t.Fatalf("unexpected removedLabelIds: %v", vars["removedLabelIds"])
+	}
+	if _, ok := vars["labelIds"]; ok {
+		t.Fatalf("expected labelIds to be left unchanged")
+	}
+	for _, teamID := range api.labelTeamIDs {
+		if teamID != "team-1" {
+			t.Fatalf("expected labels to resolve in team-1, got %q", teamID)
+		}
+	}
+	for _, line := range []string{"Labels added: backend", "Labels removed: ui", "Labels unchanged: bug"} {
+		if !strings.Contains(out.String(), line) {
+			t.Fatalf("expected %q in output, got %q", line, out.String())
+		}
+	}
+}
+
+func TestIssueUpdateRejectsMixedLabelFlags(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, _, _ := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"issue", "update", "ENG-1", "--labels", "bug", "--add-label", "ui"})
+	if code != 2 {
+		t.Fatalf("expected exit 2, got %d", code)
+	}
+	if len(api.updates) != 0 {
+		t.Fatalf("expected no update, got %d", len(api.updates))
+	}
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+type IssueHistoryCmd struct {
+	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
+	Limit   int    `help:"Maximum number of history entries to fetch" default:"100"`
+}
+
+func (c *IssueHistoryCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	if c.Limit <= 0 {
+		return exitError(2, errors.New("--limit must be greater than zero"))
+	}
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+
+	entries := []linear.IssueHistoryEntry{}
+	after := ""
+	for len(entries) < c.Limit {
+		page, err := client.IssueHistory(ctx, c.IssueID, min(c.Limit-len(entries), 100), after)
+		if err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		for _, entry := range page.Nodes {
+			if len(entry.Changes) > 0 {
+				entries = append(entries, entry)
+			}
+		}
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			break
+		}
+		after = page.PageInfo.EndCursor
+	}
+	slices.SortStableFunc(entries, func(a, b linear.IssueHistoryEntry) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(entries)
+	}
+	if len(entries) == 0 {
+		_, _ = fmt.Fprintln(cmdCtx.deps.Out, "No history")
+		return nil
+	}
+	printIssueHistory(cmdCtx.deps.Out, entries)
+	return nil
+}
+
+func printIssueHistory(w io.Writer, entries []linear.IssueHistoryEntry) {
+	for i, entry := range entries {
+		if i > 0 {
+			_, _ = fmt.Fprintln(w)
+		}
+		actor := entry.Actor
+		if actor == "" {
+			actor = "Linear"
+		}
+		_, _ = fmt.Fprintf(w, "%s  %s\n", formatTime(entry.CreatedAt), actor)
+		for _, change := range entry.Changes {
+			_, _ = fmt.Fprintf(w, "  %s: %s\n", strings.ReplaceAll(change.Field, "_", " "), describeHistoryChange(change))
+		}
+	}
+}
+
+func describeHistoryChange(change linear.HistoryChange) string {
+	if change.Added != nil || change.Removed != nil {
+		parts := []string{}
+		for _, name := range change.Added {
+			parts = append(parts, "+"+name)
+		}
+		for _, name := range change.Removed {
+			parts = append(parts, "-"+name)
+		}
+		return strings.Join(parts, " ")
+	}
+	if change.From == "" && (change.Field == "description" || change.Field == "archived" || change.Field == "trashed") {
+		return change.To
+	}
+	return historyValue(change.From) + " -> " + historyValue(change.To)
+}
+
+func historyValue(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
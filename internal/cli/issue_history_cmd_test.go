@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func newHistoryTestAPI() *fakeAPI {
+	at := func(hour int) time.Time { return time.Date(2026, 1, 2, hour, 0, 0, 0, time.UTC) }
+	api := newFakeAPI()
+	api.historyPages = []linear.IssueHistoryPage{
+		{
+			Nodes: []linear.IssueHistoryEntry{
+				{ID: "h2", CreatedAt: at(11), Actor: "Grace", Changes: []linear.HistoryChange{
+					{Field: "labels", Added: []string{"bug"}, Removed: []string{"ui"}},
+					{Field: "assignee", To: "Grace"},
+				}},
+				{ID: "h-empty", CreatedAt: at(12)},
+			},
+			PageInfo: linear.PageInfo{HasNextPage: true, EndCursor: "c1"},
+		},
+		{
+			Nodes: []linear.IssueHistoryEntry{
+				{ID: "h1", CreatedAt: at(10), Actor: "Ada", Changes: []linear.HistoryChange{
+					{Field: "state", From: "Todo", To: "In Progress"},
+				}},
+			},
+		},
+	}
+	return api
+}
+
+func TestIssueHistory(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		check func(t *testing.T, api *fakeAPI, c *cliTest)
+	}{
+		{
+			name: "renders timeline",
+			args: []string{"issue", "history", "ENG-1"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if strings.Join(api.historyAfters, ",") != ",c1" {
+					t.Fatalf("expected two pages, got cursors %v", api.historyAfters)
+				}
+				expected := "2026-01-02T10:00:00Z  Ada\n" +
+					"  state: Todo -> In Progress\n" +
+					"\n" +
+					"2026-01-02T11:00:00Z  Grace\n" +
+					"  labels: +bug -ui\n" +
+					"  assignee: (none) -> Grace\n"
+				if c.out.String() != expected {
+					t.Fatalf("unexpected output:\n%s", c.out.String())
+				}
+			},
+		},
+		{
+			name: "json",
+			args: []string{"issue", "history", "ENG-1", "--json"},
+			check: func(t *testing.T, _ *fakeAPI, c *cliTest) {
+				var entries []linear.IssueHistoryEntry
+				if err := json.Unmarshal(c.out.Bytes(), &entries); err != nil {
+					t.Fatalf("decode output: %v", err)
+				}
+				if len(entries) != 2 || entries[0].ID != "h1" || entries[1].Changes[0].Field != "labels" {
+					t.Fatalf("unexpected entries %+v", entries)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newHistoryTestAPI()
+			c := newCLITest(t, api)
+			c.run(0, tt.args...)
+			tt.check(t, api, c)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/config"
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+type IssueTemplatesCmd struct {
+	Local bool `help:"Only list local template files"`
+}
+
+type templateEntry struct {
+	Name   string `json:"name"`
+	Source string `json:"source"`
+	Team   string `json:"team,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Path   string `json:"path,omitempty"`
+}
+
+func (c *IssueTemplatesCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	entries := []templateEntry{}
+	if cmdCtx.deps.Templates != nil {
+		local, err := cmdCtx.deps.Templates.List()
+		if err != nil {
+			return exitError(1, err)
+		}
+		for _, tmpl := range local {
+			entries = append(entries, templateEntry{Name: tmpl.Name, Source: tmpl.Source, Path: tmpl.Path})
+		}
+	}
+	if !c.Local {
+		client, err := cmdCtx.apiClient()
+		if err != nil {
+			return exitError(3, err)
+		}
+		remote, err := client.IssueTemplates(ctx)
+		if err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		sort.Slice(remote, func(i, j int) bool {
+			return remote[i].Name < remote[j].Name
+		})
+		for _, tmpl := range remote {
+			entries = append(entries, templateEntry{Name: tmpl.Name, Source: "server", Team: tmpl.TeamKey, ID: tmpl.ID})
+		}
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(entries)
+	}
+	rows := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		rows = append(rows, []string{entry.Name, entry.Source, entry.Team})
+	}
+	return out.PrintTable([]string{"Name", "Source", "Team"}, rows)
+}
+
+func (c *IssueCreateCmd) applyTemplate(ctx context.Context, cmdCtx *commandContext, client linear.API, prompt func() *prompter) (string, error) {
+	if cmdCtx.deps.Templates != nil {
+		tmpl, ok, err := cmdCtx.deps.Templates.Get(c.Template)
+		if err != nil {
+			return "", exitError(1, err)
+		}
+		if ok {
+			return c.applyLocalTemplate(ctx, cmdCtx, client, tmpl, prompt)
+		}
+	}
+
+	templates, err := client.IssueTemplates(ctx)
+	if err != nil {
+		return "", exitError(mapErrorToExitCode(err), err)
+	}
+	for _, tmpl := range templates {
+		if tmpl.ID == c.Template || strings.EqualFold(tmpl.Name, c.Template) {
+			c.applyServerTemplate(tmpl)
+			return tmpl.Description, nil
+		}
+	}
+	return "", exitError(4, fmt.Errorf("template %q not found", c.Template))
+}
+
+func (c *IssueCreateCmd) applyLocalTemplate(ctx context.Context, cmdCtx *commandContext, client linear.API, tmpl config.Template, prompt func() *prompter) (string, error) {
+	values := map[string]string{}
+	for key, value := range c.Vars {
+		values[key] = value
+	}
+	data := config.TemplateData{
+		Now: cmdCtx.deps.Now(),
+		CurrentUser: func() (string, error) {
+			user, err := client.Me(ctx)
+			if err != nil {
+				return "", err
+			}
+			return user.Name, nil
+		},
+	}
+	text, err := tmpl.Render(data, func(name string) (string, error) {
+		if value, ok := values[name]; ok {
+			return value, nil
+		}
+		if !cmdCtx.interactive() {
+			return "", fmt.Errorf("template value %q is required (pass --var %s=...)", name, name)
+		}
+		value, err := prompt().text(name)
+		if err != nil {
+			return "", err
+		}
+		values[name] = value
+		return value, nil
+	})
+	if err != nil {
+		return "", exitError(mapErrorToExitCode(err), err)
+	}
+
+	if !strings.HasPrefix(strings.TrimSpace(text), frontMatterDelimiter) {
+		return strings.TrimSpace(text), nil
+	}
+	draft, err := parseIssueDraft(strings.TrimSpace(text))
+	if err != nil {
+		return "", exitError(1, fmt.Errorf("template %s: %w", tmpl.Name, err))
+	}
+	if c.Title == "" {
+		c.Title = draft.Title
+	}
+	if c.State == "" {
+		c.State = draft.State
+	}
+	if c.Assignee == "" {
+		c.Assignee = draft.Assignee
+	}
+	if c.Labels == "" {
+		c.Labels = strings.Join(draft.Labels, ",")
+	}
+	if c.Priority == "" {
+		c.Priority = draft.Priority
+	}
+	return draft.Body, nil
+}
+
+func (c *IssueCreateCmd) applyServerTemplate(tmpl linear.IssueTemplate) {
+	if c.Team == "" {
+		c.Team = tmpl.TeamKey
+	}
+	if c.Title == "" {
+		c.Title = tmpl.Title
+	}
+	if c.State == "" {
+		c.State = tmpl.StateID
+	}
+	if c.Assignee == "" {
+		c.Assignee = tmpl.AssigneeID
+	}
+	if c.Labels == "" {
+		c.Labels = strings.Join(tmpl.LabelIDs, ",")
+	}
+	if c.Project == "" {
+		c.Project = tmpl.ProjectID
+	}
+	if c.Priority == "" && tmpl.Priority != nil {
+		c.Priority = strconv.Itoa(int(*tmpl.Priority))
+	}
+	if c.Estimate == "" && tmpl.Estimate != nil {
+		c.Estimate = formatEstimate(*tmpl.Estimate)
+	}
+}
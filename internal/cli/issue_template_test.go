@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/duailibe/linear-cli/internal/config"
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func TestIssueCreateFromLocalTemplate(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	dir := t.TempDir()
+	content := "---\ntitle: Incident: {{prompt \"service\"}}\nlabels: incident\npriority: urgent\n---\n\nOpened {{.Date}} by {{.User}}.\n"
+	if err := os.WriteFile(filepath.Join(dir, "incident.md"), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	api := &fakeAPI{me: linear.User{ID: "user-1", Name: "Ada"}}
+	deps, _, errOut := newTestDeps(api)
+	deps.Templates = config.NewTemplateStore(config.TemplateDir{Path: dir, Source: "config"})
+	deps.Now = func() time.Time { return time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC) }
+
+	code := ExecuteWith(deps, []string{"issue", "create", "--team", "ENG", "--template", "incident", "--var", "service=api", "--priority", "high"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	input := api.creates[0]
+	if input.Title != "Incident: api" || input.Description != "Opened 2026-03-01 by Ada." {
+		t.Fatalf("unexpected input: %+v", input)
+	}
+	if input.Priority == nil || *input.Priority != linear.PriorityHigh {
+		t.Fatalf("expected --priority to override the template, got %v", input.Priority)
+	}
+	if len(input.LabelIDs) != 1 || input.LabelIDs[0] != "label-incident" {
+		t.Fatalf("unexpected labels: %v", input.LabelIDs)
+	}
+}
+
+func TestIssueCreateTemplateRequiresVarsWithoutInput(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "bug.md"), []byte("{{prompt \"area\"}}"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	api := &fakeAPI{}
+	deps, _, _ := newTestDeps(api)
+	deps.Templates = config.NewTemplateStore(config.TemplateDir{Path: dir, Source: "config"})
+
+	code := ExecuteWith(deps, []string{"issue", "create", "--team", "ENG", "--title", "x", "--template", "bug"})
+	if code != 1 {
+		t.Fatalf("expected exit 1, got %d", code)
+	}
+	if len(api.creates) != 0 {
+		t.Fatalf("expected no create")
+	}
+}
+
+func TestIssueCreateFromServerTemplate(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	priority := linear.PriorityLow
+	api := &fakeAPI{templates: []linear.IssueTemplate{{
+		ID:          "tmpl-1",
+		Name:        "Spike",
+		TeamKey:     "ENG",
+		Title:       "Spike: ",
+		Description: "Question to answer",
+		Priority:    &priority,
+	}}}
+	deps, _, errOut := newTestDeps(api)
+	deps.Templates = config.NewTemplateStore()
+
+	code := ExecuteWith(deps, []string{"issue", "create", "--template", "spike", "--title", "Spike: caching"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	input := api.creates[0]
+	if input.TeamID != "team-ENG" || input.Title != "Spike: caching" || input.Description != "Question to answer" {
+		t.Fatalf("unexpected input: %+v", input)
+	}
+	if input.Priority == nil || *input.Priority != linear.PriorityLow {
+		t.Fatalf("unexpected priority: %v", input.Priority)
+	}
+}
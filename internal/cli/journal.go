@@ -0,0 +1,568 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/duailibe/linear-cli/internal/journal"
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+const (
+	opIssueCreate      = "issue.create"
+	opIssueUpdate      = "issue.update"
+	opIssueArchive     = "issue.archive"
+	opIssueUnarchive   = "issue.unarchive"
+	opIssueDelete      = "issue.delete"
+	opIssueSubscribe   = "issue.subscribe"
+	opIssueUnsubscribe = "issue.unsubscribe"
+	opCommentCreate    = "comment.create"
+	opCommentUpdate    = "comment.update"
+	opCommentDelete    = "comment.delete"
+	opCommentResolve   = "comment.resolve"
+	opCommentUnresolve = "comment.unresolve"
+	opReactionCreate   = "reaction.create"
+	opReactionDelete   = "reaction.delete"
+	opRelationCreate   = "relation.create"
+	opRelationDelete   = "relation.delete"
+	opLinkCreate       = "link.create"
+	opLinkDelete       = "link.delete"
+)
+
+// journalAPI records every mutation it forwards in the local journal, along
+// with enough before/after state for `linear undo` to reverse it.
+type journalAPI struct {
+	linear.API
+
+	store   *journal.Store
+	run     string
+	command string
+	now     func() time.Time
+	errOut  io.Writer
+	undoOf  string
+
+	mu        sync.Mutex
+	relations map[string]linear.IssueRelation
+	reactions map[string]reactionState
+	links     map[string]linkState
+}
+
+type reactionState struct {
+	ID        string `json:"id"`
+	Emoji     string `json:"emoji"`
+	IssueID   string `json:"issue_id,omitempty"`
+	CommentID string `json:"comment_id,omitempty"`
+}
+
+type linkState struct {
+	ID       string `json:"id"`
+	IssueID  string `json:"issue_id"`
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle,omitempty"`
+	URL      string `json:"url"`
+}
+
+type commentState struct {
+	CommentID string `json:"comment_id"`
+	Body      string `json:"body,omitempty"`
+}
+
+type subscriptionState struct {
+	UserID string `json:"user_id"`
+}
+
+type deleteState struct {
+	Permanent bool `json:"permanent"`
+}
+
+func (c *commandContext) journaled(client linear.API) *journalAPI {
+	now := c.deps.Now
+	if now == nil {
+		now = time.Now
+	}
+	return &journalAPI{
+		API:       client,
+		store:     c.deps.Journal,
+		run:       c.run,
+		command:   c.command,
+		now:       now,
+		errOut:    c.deps.Err,
+		relations: map[string]linear.IssueRelation{},
+		reactions: map[string]reactionState{},
+		links:     map[string]linkState{},
+	}
+}
+
+func (j *journalAPI) record(entry journal.Entry) {
+	entry.ID = journal.NewID()
+	entry.Run = j.run
+	entry.Time = j.now().UTC()
+	entry.Command = j.command
+	entry.UndoOf = j.undoOf
+	if err := j.store.Append(entry); err != nil {
+		_, _ = fmt.Fprintf(j.errOut, "warning: %v\n", err)
+	}
+}
+
+func (j *journalAPI) remember(relations ...linear.IssueRelation) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, rel := range relations {
+		j.relations[rel.ID] = rel
+	}
+}
+
+// describe looks up the issue a mutation targets so the journal can show its
+// identifier. Lookup failures are not fatal; the mutation reports its own.
+func (j *journalAPI) describe(ctx context.Context, issueID string) (linear.IssueDetail, bool) {
+	issue, err := j.API.Issue(ctx, issueID)
+	if err != nil {
+		return linear.IssueDetail{ID: issueID}, false
+	}
+	return issue, true
+}
+
+func (j *journalAPI) rememberReactions(issueID, commentID string, reactions []linear.Reaction) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, reaction := range reactions {
+		j.reactions[reaction.ID] = reactionState{ID: reaction.ID, Emoji: reaction.Emoji, IssueID: issueID, CommentID: commentID}
+	}
+}
+
+func (j *journalAPI) rememberLinks(links ...linkState) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	for _, link := range links {
+		j.links[link.ID] = link
+	}
+}
+
+func (j *journalAPI) Issue(ctx context.Context, value string) (linear.IssueDetail, error) {
+	issue, err := j.API.Issue(ctx, value)
+	if err == nil {
+		j.rememberReactions(issue.ID, "", issue.Reactions)
+	}
+	return issue, err
+}
+
+func (j *journalAPI) Comment(ctx context.Context, commentID string) (linear.Comment, error) {
+	comment, err := j.API.Comment(ctx, commentID)
+	if err == nil {
+		j.rememberReactions("", comment.ID, comment.Reactions)
+	}
+	return comment, err
+}
+
+func (j *journalAPI) IssueRelations(ctx context.Context, issueID string, limit int) (linear.IssueRelationSet, error) {
+	set, err := j.API.IssueRelations(ctx, issueID, limit)
+	if err != nil {
+		return set, err
+	}
+	j.remember(set.Relations...)
+	j.remember(set.InverseRelations...)
+	return set, nil
+}
+
+func (j *journalAPI) IssueAttachments(ctx context.Context, issueID string, limit int) ([]linear.Attachment, error) {
+	attachments, err := j.API.IssueAttachments(ctx, issueID, limit)
+	if err != nil {
+		return attachments, err
+	}
+	for _, attachment := range attachments {
+		j.rememberLinks(linkState{ID: attachment.ID, IssueID: issueID, Title: attachment.Title, Subtitle: attachment.Subtitle, URL: attachment.URL})
+	}
+	return attachments, nil
+}
+
+func (j *journalAPI) IssueCreate(ctx context.Context, input linear.IssueCreateInput) (linear.IssueSummary, error) {
+	issue, err := j.API.IssueCreate(ctx, input)
+	if err != nil {
+		return issue, err
+	}
+	j.record(journal.Entry{Op: opIssueCreate, IssueID: issue.ID, Issue: issue.Identifier, After: mustJSON(issue)})
+	return issue, nil
+}
+
+func (j *journalAPI) IssueUpdate(ctx context.Context, issueID string, input linear.IssueUpdateInput) (linear.IssueSummary, error) {
+	before, err := j.API.Issue(ctx, issueID)
+	if err != nil {
+		return linear.IssueSummary{}, err
+	}
+	issue, err := j.API.IssueUpdate(ctx, issueID, input)
+	if err != nil {
+		return issue, err
+	}
+	entry := journal.Entry{
+		Op:      opIssueUpdate,
+		IssueID: before.ID,
+		Issue:   before.Identifier,
+		Fields:  updatedFields(input),
+		Before:  mustJSON(issueSnapshot(before)),
+	}
+	if after, err := j.API.Issue(ctx, before.ID); err == nil {
+		entry.After = mustJSON(issueSnapshot(after))
+	}
+	j.record(entry)
+	return issue, nil
+}
+
+func (j *journalAPI) IssueArchive(ctx context.Context, issueID string) error {
+	return j.issueAction(ctx, opIssueArchive, issueID, nil, func() error {
+		return j.API.IssueArchive(ctx, issueID)
+	})
+}
+
+func (j *journalAPI) IssueUnarchive(ctx context.Context, issueID string) error {
+	return j.issueAction(ctx, opIssueUnarchive, issueID, nil, func() error {
+		return j.API.IssueUnarchive(ctx, issueID)
+	})
+}
+
+func (j *journalAPI) IssueDelete(ctx context.Context, issueID string, permanent bool) error {
+	return j.issueAction(ctx, opIssueDelete, issueID, mustJSON(deleteState{Permanent: permanent}), func() error {
+		return j.API.IssueDelete(ctx, issueID, permanent)
+	})
+}
+
+func (j *journalAPI) IssueSubscribe(ctx context.Context, issueID, userID string) error {
+	return j.issueAction(ctx, opIssueSubscribe, issueID, mustJSON(subscriptionState{UserID: userID}), func() error {
+		return j.API.IssueSubscribe(ctx, issueID, userID)
+	})
+}
+
+func (j *journalAPI) IssueUnsubscribe(ctx context.Context, issueID, userID string) error {
+	return j.issueAction(ctx, opIssueUnsubscribe, issueID, mustJSON(subscriptionState{UserID: userID}), func() error {
+		return j.API.IssueUnsubscribe(ctx, issueID, userID)
+	})
+}
+
+func (j *journalAPI) issueAction(ctx context.Context, op, issueID string, after json.RawMessage, apply func() error) error {
+	issue, found := j.describe(ctx, issueID)
+	if err := apply(); err != nil {
+		return err
+	}
+	entry := journal.Entry{Op: op, IssueID: issue.ID, Issue: issue.Identifier, After: after}
+	if found {
+		entry.Before = mustJSON(issueSnapshot(issue))
+	}
+	j.record(entry)
+	return nil
+}
+
+func (j *journalAPI) IssueComment(ctx context.Context, issueID, body string) (string, error) {
+	issue, _ := j.describe(ctx, issueID)
+	commentID, err := j.API.IssueComment(ctx, issueID, body)
+	if err != nil {
+		return commentID, err
+	}
+	j.record(journal.Entry{Op: opCommentCreate, IssueID: issue.ID, Issue: issue.Identifier, After: mustJSON(commentState{CommentID: commentID})})
+	return commentID, nil
+}
+
+func (j *journalAPI) CommentReply(ctx context.Context, issueID, parentID, body string) (string, error) {
+	issue, _ := j.describe(ctx, issueID)
+	commentID, err := j.API.CommentReply(ctx, issueID, parentID, body)
+	if err != nil {
+		return commentID, err
+	}
+	j.record(journal.Entry{Op: opCommentCreate, IssueID: issue.ID, Issue: issue.Identifier, After: mustJSON(commentState{CommentID: commentID})})
+	return commentID, nil
+}
+
+func (j *journalAPI) CommentUpdate(ctx context.Context, commentID, body string) error {
+	before, err := j.API.Comment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if err := j.API.CommentUpdate(ctx, commentID, body); err != nil {
+		return err
+	}
+	j.record(journal.Entry{
+		Op:      opCommentUpdate,
+		IssueID: before.IssueID,
+		Issue:   before.Issue,
+		Fields:  []string{"body"},
+		Before:  mustJSON(commentState{CommentID: commentID, Body: before.Body}),
+		After:   mustJSON(commentState{CommentID: commentID, Body: body}),
+	})
+	return nil
+}
+
+func (j *journalAPI) CommentResolve(ctx context.Context, commentID string) error {
+	return j.commentAction(ctx, opCommentResolve, commentID, func() error {
+		return j.API.CommentResolve(ctx, commentID)
+	})
+}
+
+func (j *journalAPI) CommentUnresolve(ctx context.Context, commentID string) error {
+	return j.commentAction(ctx, opCommentUnresolve, commentID, func() error {
+		return j.API.CommentUnresolve(ctx, commentID)
+	})
+}
+
+func (j *journalAPI) CommentDelete(ctx context.Context, commentID string) error {
+	return j.commentAction(ctx, opCommentDelete, commentID, func() error {
+		return j.API.CommentDelete(ctx, commentID)
+	})
+}
+
+func (j *journalAPI) commentAction(ctx context.Context, op, commentID string, apply func() error) error {
+	comment, err := j.API.Comment(ctx, commentID)
+	if err != nil {
+		comment = linear.Comment{ID: commentID}
+	}
+	if err := apply(); err != nil {
+		return err
+	}
+	j.record(journal.Entry{
+		Op:      op,
+		IssueID: comment.IssueID,
+		Issue:   comment.Issue,
+		Before:  mustJSON(commentState{CommentID: commentID, Body: comment.Body}),
+	})
+	return nil
+}
+
+func (j *journalAPI) ReactionCreate(ctx context.Context, issueID, commentID, emoji string) (linear.Reaction, error) {
+	reaction, err := j.API.ReactionCreate(ctx, issueID, commentID, emoji)
+	if err != nil {
+		return reaction, err
+	}
+	entry := journal.Entry{
+		Op:     opReactionCreate,
+		Fields: []string{emoji},
+		After:  mustJSON(reactionState{ID: reaction.ID, Emoji: emoji, IssueID: issueID, CommentID: commentID}),
+	}
+	if issueID != "" {
+		issue, _ := j.describe(ctx, issueID)
+		entry.IssueID, entry.Issue = issue.ID, issue.Identifier
+	}
+	j.record(entry)
+	return reaction, nil
+}
+
+func (j *journalAPI) ReactionDelete(ctx context.Context, reactionID string) error {
+	if err := j.API.ReactionDelete(ctx, reactionID); err != nil {
+		return err
+	}
+	entry := journal.Entry{Op: opReactionDelete}
+	j.mu.Lock()
+	state, ok := j.reactions[reactionID]
+	j.mu.Unlock()
+	if ok {
+		entry.IssueID = state.IssueID
+		entry.Fields = []string{state.Emoji}
+		entry.Before = mustJSON(state)
+	}
+	j.record(entry)
+	return nil
+}
+
+func (j *journalAPI) IssueRelationCreate(ctx context.Context, issueID, relatedIssueID, relationType string) (linear.IssueRelation, error) {
+	issue, _ := j.describe(ctx, issueID)
+	rel, err := j.API.IssueRelationCreate(ctx, issueID, relatedIssueID, relationType)
+	if err != nil {
+		return rel, err
+	}
+	j.remember(rel)
+	j.record(journal.Entry{Op: opRelationCreate, IssueID: issue.ID, Issue: issue.Identifier, Fields: []string{relationType}, After: mustJSON(rel)})
+	return rel, nil
+}
+
+func (j *journalAPI) IssueRelationDelete(ctx context.Context, relationID string) error {
+	if err := j.API.IssueRelationDelete(ctx, relationID); err != nil {
+		return err
+	}
+	entry := journal.Entry{Op: opRelationDelete}
+	j.mu.Lock()
+	rel, ok := j.relations[relationID]
+	j.mu.Unlock()
+	if ok {
+		entry.IssueID = rel.IssueID
+		entry.Issue = rel.Issue.Identifier
+		entry.Fields = []string{rel.Type}
+		entry.Before = mustJSON(rel)
+	}
+	j.record(entry)
+	return nil
+}
+
+// AttachmentCreate records the link it replaces, if any: attachmentCreate
+// updates an attachment whose URL is already linked to the issue, so undo
+// must restore that link rather than delete it.
+func (j *journalAPI) AttachmentCreate(ctx context.Context, input linear.AttachmentCreateInput) (linear.Attachment, error) {
+	issue, _ := j.describe(ctx, input.IssueID)
+	existing, err := j.API.IssueAttachments(ctx, input.IssueID, unlinkScanLimit)
+	if err != nil {
+		return linear.Attachment{}, err
+	}
+	var before json.RawMessage
+	for _, attachment := range existing {
+		if attachment.URL == input.URL {
+			before = mustJSON(linkState{ID: attachment.ID, IssueID: input.IssueID, Title: attachment.Title, Subtitle: attachment.Subtitle, URL: attachment.URL})
+			break
+		}
+	}
+	attachment, err := j.API.AttachmentCreate(ctx, input)
+	if err != nil {
+		return attachment, err
+	}
+	state := linkState{ID: attachment.ID, IssueID: issue.ID, Title: input.Title, Subtitle: input.Subtitle, URL: input.URL}
+	j.rememberLinks(state)
+	j.record(journal.Entry{Op: opLinkCreate, IssueID: issue.ID, Issue: issue.Identifier, Fields: []string{input.URL}, Before: before, After: mustJSON(state)})
+	return attachment, nil
+}
+
+func (j *journalAPI) AttachmentDelete(ctx context.Context, attachmentID string) error {
+	if err := j.API.AttachmentDelete(ctx, attachmentID); err != nil {
+		return err
+	}
+	entry := journal.Entry{Op: opLinkDelete}
+	j.mu.Lock()
+	state, ok := j.links[attachmentID]
+	j.mu.Unlock()
+	if ok {
+		issue, _ := j.describe(ctx, state.IssueID)
+		entry.IssueID = issue.ID
+		entry.Issue = issue.Identifier
+		entry.Fields = []string{state.URL}
+		entry.Before = mustJSON(state)
+	}
+	j.record(entry)
+	return nil
+}
+
+// issueSnapshot captures the restorable fields of an issue keyed by their
+// issueUpdate input names.
+func issueSnapshot(issue linear.IssueDetail) map[string]any {
+	labelIDs := slices.Clone(issue.LabelIDs)
+	if labelIDs == nil {
+		labelIDs = []string{}
+	}
+	slices.Sort(labelIDs)
+	snapshot := map[string]any{
+		"teamId":      issue.TeamID,
+		"title":       issue.Title,
+		"description": issue.Description,
+		"stateId":     issue.StateID,
+		"assigneeId":  optionalID(issue.AssigneeID),
+		"priority":    int(issue.Priority),
+		"projectId":   optionalID(issue.ProjectID),
+		"cycleId":     optionalID(issue.CycleID),
+		"labelIds":    labelIDs,
+		"parentId":    optionalID(issue.ParentID),
+		"estimate":    nil,
+		"dueDate":     nil,
+	}
+	if issue.Estimate != nil {
+		snapshot["estimate"] = *issue.Estimate
+	}
+	if issue.DueDate != nil {
+		snapshot["dueDate"] = issue.DueDate.String()
+	}
+	return snapshot
+}
+
+func optionalID(id string) any {
+	if id == "" {
+		return nil
+	}
+	return id
+}
+
+func updatedFields(input linear.IssueUpdateInput) []string {
+	fields := []string{}
+	for key := range input.Variables() {
+		if key == "addedLabelIds" || key == "removedLabelIds" {
+			key = "labelIds"
+		}
+		if !slices.Contains(fields, key) {
+			fields = append(fields, key)
+		}
+	}
+	slices.Sort(fields)
+	return fields
+}
+
+// restoreInput builds an update that puts the given fields back to the values
+// recorded in a snapshot.
+func restoreInput(snapshot map[string]any, fields []string) (linear.IssueUpdateInput, error) {
+	var input linear.IssueUpdateInput
+	for _, field := range fields {
+		value, ok := snapshot[field]
+		if !ok {
+			return input, fmt.Errorf("no recorded value for %s", field)
+		}
+		switch field {
+		case "teamId":
+			input.TeamID = restoreString(value)
+		case "title":
+			input.Title = restoreString(value)
+		case "description":
+			input.Description = restoreString(value)
+		case "stateId":
+			input.StateID = restoreString(value)
+		case "assigneeId":
+			input.AssigneeID = restoreString(value)
+		case "projectId":
+			input.ProjectID = restoreString(value)
+		case "cycleId":
+			input.CycleID = restoreString(value)
+		case "parentId":
+			input.ParentID = restoreString(value)
+		case "priority":
+			number, _ := value.(float64)
+			input.Priority = linear.Set(linear.Priority(number))
+		case "estimate":
+			if number, ok := value.(float64); ok {
+				input.Estimate = linear.Set(number)
+			} else {
+				input.Estimate = linear.Null[float64]()
+			}
+		case "dueDate":
+			text, ok := value.(string)
+			if !ok {
+				input.DueDate = linear.Null[linear.Date]()
+				continue
+			}
+			due, err := linear.ParseDate(text)
+			if err != nil {
+				return input, err
+			}
+			input.DueDate = linear.Set(due)
+		case "labelIds":
+			items, _ := value.([]any)
+			ids := make([]string, 0, len(items))
+			for _, item := range items {
+				if id, ok := item.(string); ok {
+					ids = append(ids, id)
+				}
+			}
+			input.LabelIDs = linear.Set(ids)
+		default:
+			return input, fmt.Errorf("cannot restore %s", field)
+		}
+	}
+	return input, nil
+}
+
+func restoreString(value any) linear.Nullable[string] {
+	if text, ok := value.(string); ok {
+		return linear.Set(text)
+	}
+	return linear.Null[string]()
+}
+
+func mustJSON(v any) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+type IssueLinkCmd struct {
+	IssueID  string `arg:"" name:"issue-id" help:"Issue ID"`
+	URL      string `arg:"" name:"url" help:"URL to link"`
+	Title    string `help:"Link title (defaults to the URL)"`
+	Subtitle string `help:"Link subtitle"`
+}
+
+type IssueLinksCmd struct {
+	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
+	Limit   int    `help:"Maximum number of links" default:"50"`
+}
+
+type IssueUnlinkCmd struct {
+	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
+	Link    string `arg:"" name:"link" help:"Link ID or URL to remove"`
+}
+
+type linkResult struct {
+	linear.Attachment
+	Identifier string `json:"identifier"`
+	Action     string `json:"action"`
+}
+
+// unlinkScanLimit bounds how many attachments are searched when removing a
+// link by URL.
+const unlinkScanLimit = 250
+
+func (c *IssueLinkCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	if err := validateLinkURL(c.URL); err != nil {
+		return exitError(2, err)
+	}
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	issue, err := client.Issue(ctx, c.IssueID)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	title := c.Title
+	if strings.TrimSpace(title) == "" {
+		title = c.URL
+	}
+	attachment, err := client.AttachmentCreate(ctx, linear.AttachmentCreateInput{
+		IssueID:  issue.ID,
+		Title:    title,
+		Subtitle: c.Subtitle,
+		URL:      c.URL,
+	})
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	return printLinkResults(cmdCtx, []linkResult{{Attachment: attachment, Identifier: issue.Identifier, Action: "linked"}})
+}
+
+func (c *IssueLinksCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	if c.Limit <= 0 {
+		return exitError(2, errors.New("--limit must be greater than zero"))
+	}
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	issueID, err := client.ResolveIssueID(ctx, c.IssueID)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	attachments, err := client.IssueAttachments(ctx, issueID, c.Limit)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(attachments)
+	}
+	rows := make([][]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		rows = append(rows, []string{attachment.ID, attachment.Title, attachment.Source, attachment.URL, attachment.CreatedAt})
+	}
+	return out.PrintTable([]string{"ID", "Title", "Source", "URL", "Created"}, rows)
+}
+
+func (c *IssueUnlinkCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	issue, err := client.Issue(ctx, c.IssueID)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	attachments, err := client.IssueAttachments(ctx, issue.ID, unlinkScanLimit)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+
+	matches := matchLinks(attachments, c.Link)
+	if len(matches) == 0 {
+		return exitError(4, fmt.Errorf("no link %q on %s", c.Link, issue.Identifier))
+	}
+	results := make([]linkResult, 0, len(matches))
+	for _, attachment := range matches {
+		if err := client.AttachmentDelete(ctx, attachment.ID); err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		results = append(results, linkResult{Attachment: attachment, Identifier: issue.Identifier, Action: "unlinked"})
+	}
+	return printLinkResults(cmdCtx, results)
+}
+
+// matchLinks finds attachments by ID, or by URL ignoring a trailing slash; a
+// URL linked more than once matches every copy.
+func matchLinks(attachments []linear.Attachment, value string) []linear.Attachment {
+	for _, attachment := range attachments {
+		if attachment.ID == value {
+			return []linear.Attachment{attachment}
+		}
+	}
+	want := strings.TrimSuffix(value, "/")
+	matches := []linear.Attachment{}
+	for _, attachment := range attachments {
+		if attachment.URL != "" && strings.TrimSuffix(attachment.URL, "/") == want {
+			matches = append(matches, attachment)
+		}
+	}
+	return matches
+}
+
+func validateLinkURL(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid url %q: must be an absolute http(s) URL", value)
+	}
+	return nil
+}
+
+func printLinkResults(cmdCtx *commandContext, results []linkResult) error {
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(results)
+	}
+	rows := make([][]string, 0, len(results))
+	for _, result := range results {
+		rows = append(rows, []string{result.ID, result.Title, result.URL, result.Action})
+	}
+	return out.PrintTable([]string{"ID", "Title", "URL", "Action"}, rows)
+}
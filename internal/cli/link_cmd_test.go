@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func newLinkTestAPI() *fakeAPI {
+	api := newFakeAPI(linear.IssueDetail{ID: "issue-1", Identifier: "ENG-1"})
+	api.attachments = []linear.Attachment{
+		{ID: "att-1", Title: "PR #42", URL: "https://github.com/org/repo/pull/42", Source: "github"},
+		{ID: "att-2", Title: "Dashboard", URL: "https://grafana.example.com/d/1/"},
+	}
+	return api
+}
+
+func TestIssueLink(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		code int
+		// undo runs "undo" after the command, with a journal.
+		undo  bool
+		check func(t *testing.T, api *fakeAPI, c *cliTest)
+	}{
+		{
+			name: "link creates attachment",
+			args: []string{"issue", "link", "ENG-1", "https://github.com/org/repo/pull/42", "--title", "PR #42"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if len(api.createdLinks) != 1 || api.createdLinks[0].IssueID != "issue-1" || api.createdLinks[0].Title != "PR #42" {
+					t.Fatalf("unexpected creates %v", api.createdLinks)
+				}
+				if fields := strings.Fields(c.out.String()); fields[len(fields)-1] != "linked" {
+					t.Fatalf("unexpected output %q", c.out.String())
+				}
+			},
+		},
+		{
+			name: "link title defaults to the URL",
+			args: []string{"issue", "link", "ENG-1", "https://docs.example.com/spec"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if api.createdLinks[0].Title != "https://docs.example.com/spec" {
+					t.Fatalf("expected title to default to the URL, got %q", api.createdLinks[0].Title)
+				}
+			},
+		},
+		{name: "link rejects a relative URL", args: []string{"issue", "link", "ENG-1", "github.com/org/repo"}, code: 2},
+		{
+			name: "links lists attachments",
+			args: []string{"issue", "links", "ENG-1"},
+			check: func(t *testing.T, _ *fakeAPI, c *cliTest) {
+				lines := strings.Split(strings.TrimSpace(c.out.String()), "\n")
+				if len(lines) != 3 {
+					t.Fatalf("expected header and 2 rows, got %q", c.out.String())
+				}
+				if fields := strings.Fields(lines[1]); fields[0] != "att-1" || fields[len(fields)-2] != "github" {
+					t.Fatalf("unexpected row %q", lines[1])
+				}
+			},
+		},
+		{
+			name: "unlink matches URL ignoring trailing slash",
+			args: []string{"issue", "unlink", "ENG-1", "https://grafana.example.com/d/1"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if strings.Join(api.deletedLinks, ",") != "att-2" {
+					t.Fatalf("unexpected deletes %v", api.deletedLinks)
+				}
+			},
+		},
+		{
+			name: "unlink matches ID",
+			args: []string{"issue", "unlink", "ENG-1", "att-1"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if strings.Join(api.deletedLinks, ",") != "att-1" {
+					t.Fatalf("unexpected deletes %v", api.deletedLinks)
+				}
+			},
+		},
+		{name: "unlink unknown link", args: []string{"issue", "unlink", "ENG-1", "https://example.com/missing"}, code: 4},
+		{
+			name: "undo unlink restores the link",
+			args: []string{"issue", "unlink", "ENG-1", "att-1"},
+			undo: true,
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.createdLinks) != 1 || api.createdLinks[0].URL != "https://github.com/org/repo/pull/42" || api.createdLinks[0].Title != "PR #42" {
+					t.Fatalf("expected link to be restored, got %v", api.createdLinks)
+				}
+			},
+		},
+		{
+			name: "undo link deletes the new link",
+			args: []string{"issue", "link", "ENG-1", "https://docs.example.com/spec"},
+			undo: true,
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if strings.Join(api.deletedLinks, ",") != "att-new" {
+					t.Fatalf("unexpected deletes %v", api.deletedLinks)
+				}
+			},
+		},
+		{
+			name: "undo link of an existing URL restores it",
+			args: []string{"issue", "link", "ENG-1", "https://github.com/org/repo/pull/42", "--title", "Renamed"},
+			undo: true,
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.deletedLinks) != 0 {
+					t.Fatalf("expected the existing link to be kept, got deletes %v", api.deletedLinks)
+				}
+				if len(api.createdLinks) != 2 || api.createdLinks[1].Title != "PR #42" || api.createdLinks[1].URL != "https://github.com/org/repo/pull/42" {
+					t.Fatalf("expected the old title to be restored, got %v", api.createdLinks)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newLinkTestAPI()
+			c := newCLITest(t, api)
+			if tt.undo {
+				c.withJournal()
+			}
+			c.run(tt.code, tt.args...)
+			if tt.undo {
+				c.run(0, "undo")
+			}
+			if tt.check != nil {
+				tt.check(t, api, c)
+			}
+		})
+	}
+}
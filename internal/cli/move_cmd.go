@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+type IssueMoveCmd struct {
+	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
+	To      string `help:"Destination team key or ID" required:""`
+}
+
+type fieldMapping struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to,omitempty"`
+}
+
+type issueMovePlan struct {
+	Issue      string         `json:"issue"`
+	Identifier string         `json:"identifier,omitempty"`
+	URL        string         `json:"url,omitempty"`
+	FromTeam   string         `json:"from_team"`
+	ToTeam     string         `json:"to_team"`
+	Mappings   []fieldMapping `json:"mappings"`
+	Unmapped   []fieldMapping `json:"unmapped"`
+	DryRun     bool           `json:"dry_run"`
+
+	input linear.IssueUpdateInput
+}
+
+func (c *IssueMoveCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	issue, err := client.Issue(ctx, c.IssueID)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	teamID, err := client.ResolveTeamID(ctx, c.To)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	if teamID == issue.TeamID {
+		return exitError(2, fmt.Errorf("%s is already in team %s", issue.Identifier, c.To))
+	}
+
+	plan, err := planIssueMove(ctx, client, issue, teamID)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	plan.ToTeam = c.To
+	plan.DryRun = cmdCtx.global.DryRun
+
+	for _, m := range plan.Unmapped {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Err, "warning: %s %q has no match in %s\n", m.Field, m.From, c.To)
+	}
+	updated, err := client.IssueUpdate(ctx, issue.ID, plan.input)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	plan.Identifier = updated.Identifier
+	plan.URL = updated.URL
+
+	out := outputFor(cmdCtx)
+	if plan.DryRun {
+		out.Out = cmdCtx.deps.Err
+	}
+	if out.JSON {
+		return out.PrintJSON(plan)
+	}
+	rows := [][]string{{"team", issue.TeamKey, plan.ToTeam}}
+	for _, m := range plan.Mappings {
+		rows = append(rows, []string{m.Field, m.From, m.To})
+	}
+	for _, m := range plan.Unmapped {
+		to := "(removed)"
+		if m.Field == "state" {
+			to = "(team default)"
+		}
+		rows = append(rows, []string{m.Field, m.From, to})
+	}
+	if err := out.PrintTable([]string{"Field", "From", "To"}, rows); err != nil {
+		return err
+	}
+	if !plan.DryRun && plan.Identifier != "" {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Moved %s to %s\n", issue.Identifier, plan.Identifier)
+	}
+	return nil
+}
+
+func planIssueMove(ctx context.Context, client linear.API, issue linear.IssueDetail, teamID string) (issueMovePlan, error) {
+	plan := issueMovePlan{
+		Issue:    issue.Identifier,
+		FromTeam: issue.TeamKey,
+		Mappings: []fieldMapping{},
+		Unmapped: []fieldMapping{},
+		input:    linear.IssueUpdateInput{TeamID: linear.Set(teamID)},
+	}
+
+	states, err := client.WorkflowStates(ctx, teamID)
+	if err != nil {
+		return plan, err
+	}
+	if state, ok := matchWorkflowState(states, issue.State, issue.StateType); ok {
+		plan.input.StateID = linear.Set(state.ID)
+		plan.Mappings = append(plan.Mappings, fieldMapping{Field: "state", From: issue.State, To: state.Name})
+	} else if issue.State != "" {
+		plan.Unmapped = append(plan.Unmapped, fieldMapping{Field: "state", From: issue.State})
+	}
+
+	if len(issue.LabelIDs) > 0 {
+		labels, err := client.IssueLabels(ctx, teamID)
+		if err != nil {
+			return plan, err
+		}
+		labelIDs := []string{}
+		for i, id := range issue.LabelIDs {
+			name := id
+			if i < len(issue.Labels) {
+				name = issue.Labels[i]
+			}
+			label, ok := matchLabel(labels, id, name)
+			if !ok {
+				plan.Unmapped = append(plan.Unmapped, fieldMapping{Field: "label", From: name})
+				continue
+			}
+			labelIDs = append(labelIDs, label.ID)
+			plan.Mappings = append(plan.Mappings, fieldMapping{Field: "label", From: name, To: label.Name})
+		}
+		plan.input.LabelIDs = linear.Set(labelIDs)
+	}
+
+	if issue.Cycle != "" {
+		plan.input.CycleID = linear.Null[string]()
+		plan.Unmapped = append(plan.Unmapped, fieldMapping{Field: "cycle", From: issue.Cycle})
+	}
+	return plan, nil
+}
+
+func matchWorkflowState(states []linear.WorkflowState, name, stateType string) (linear.WorkflowState, bool) {
+	for _, state := range states {
+		if strings.EqualFold(state.Name, name) {
+			return state, true
+		}
+	}
+	if stateType == "" {
+		return linear.WorkflowState{}, false
+	}
+	for _, state := range states {
+		if strings.EqualFold(state.Type, stateType) {
+			return state, true
+		}
+	}
+	return linear.WorkflowState{}, false
+}
+
+func matchLabel(labels []linear.Label, id, name string) (linear.Label, bool) {
+	for _, label := range labels {
+		if label.ID == id {
+			return label, true
+		}
+	}
+	for _, label := range labels {
+		if strings.EqualFold(label.Name, name) {
+			return label, true
+		}
+	}
+	return linear.Label{}, false
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func newMoveTestAPI() *fakeAPI {
+	api := newFakeAPI(linear.IssueDetail{
+		ID:         "issue-1",
+		Identifier: "ENG-1",
+		Title:      "Flaky deploy",
+		TeamID:     "team-ENG",
+		TeamKey:    "ENG",
+		State:      "In Progress",
+		StateType:  "started",
+		Labels:     []string{"customer", "bug", "frontend"},
+		LabelIDs:   []string{"label-ws", "eng-bug", "eng-frontend"},
+		Cycle:      "Cycle 12",
+	})
+	api.states = map[string][]linear.WorkflowState{
+		"team-OPS": {
+			{ID: "ops-backlog", Name: "Backlog", Type: "backlog"},
+			{ID: "ops-doing", Name: "Doing", Type: "started"},
+		},
+	}
+	api.labels = map[string][]linear.Label{
+		"team-OPS": {
+			{ID: "label-ws", Name: "customer"},
+			{ID: "ops-bug", Name: "Bug", TeamID: "team-OPS"},
+		},
+	}
+	return api
+}
+
+func TestIssueMove(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		code  int
+		check func(t *testing.T, api *fakeAPI, c *cliTest)
+	}{
+		{
+			name: "maps state and labels",
+			args: []string{"issue", "move", "ENG-1", "--to", "OPS"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if len(api.updates) != 1 {
+					t.Fatalf("expected one update, got %d", len(api.updates))
+				}
+				input := api.updates[0].Input
+				if team, _ := input.TeamID.Value(); team != "team-OPS" {
+					t.Fatalf("expected team-OPS, got %q", team)
+				}
+				if state, _ := input.StateID.Value(); state != "ops-doing" {
+					t.Fatalf("expected state mapped by type to ops-doing, got %q", state)
+				}
+				labels, _ := input.LabelIDs.Value()
+				if strings.Join(labels, ",") != "label-ws,ops-bug" {
+					t.Fatalf("unexpected labels %v", labels)
+				}
+				if !input.CycleID.IsNull() {
+					t.Fatal("expected cycle to be cleared")
+				}
+				for _, want := range []string{`label "frontend" has no match in OPS`, `cycle "Cycle 12" has no match in OPS`} {
+					if !strings.Contains(c.errOut.String(), want) {
+						t.Fatalf("expected warning %q, got %q", want, c.errOut.String())
+					}
+				}
+				if !strings.Contains(c.out.String(), "(removed)") {
+					t.Fatalf("expected unmapped label in output, got %q", c.out.String())
+				}
+			},
+		},
+		{
+			name: "dry run",
+			args: []string{"--json", "issue", "move", "ENG-1", "--to", "OPS", "--dry-run"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if len(api.updates) != 0 {
+					t.Fatalf("expected no updates, got %d", len(api.updates))
+				}
+				if !strings.Contains(c.out.String(), "issueUpdate(id: $id, input: $input)") || !strings.Contains(c.out.String(), `"stateId": "ops-doing"`) {
+					t.Fatalf("expected the update mutation on stdout, got %s", c.out.String())
+				}
+				if !strings.Contains(c.errOut.String(), `"dry_run": true`) || !strings.Contains(c.errOut.String(), `"to": "Doing"`) {
+					t.Fatalf("expected the move plan on stderr, got %s", c.errOut.String())
+				}
+			},
+		},
+		{name: "same team", args: []string{"issue", "move", "ENG-1", "--to", "ENG"}, code: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newMoveTestAPI()
+			c := newCLITest(t, api)
+			c.run(tt.code, tt.args...)
+			if tt.check != nil {
+				tt.check(t, api, c)
+			}
+		})
+	}
+}
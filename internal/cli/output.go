@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"strconv"
 	"text/tabwriter"
+	"time"
 )
 
 type output struct {
@@ -39,3 +41,14 @@ func joinRow(cols []string) string {
 	}
 	return out
 }
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func formatEstimate(estimate float64) string {
+	return strconv.FormatFloat(estimate, 'f', -1, 64)
+}
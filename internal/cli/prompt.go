@@ -0,0 +1,205 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+const maxPromptChoices = 15
+
+type promptChoice struct {
+	Label string
+	Value string
+}
+
+type prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+func newPrompter(cmdCtx *commandContext) *prompter {
+	return &prompter{in: bufio.NewReader(cmdCtx.deps.In), out: cmdCtx.deps.Err}
+}
+
+func (c *commandContext) interactive() bool {
+	return c.deps.Interactive && !c.global.NoInput
+}
+
+// openTerminal opens the controlling terminal so commands whose stdin is
+// piped input can still ask for confirmation.
+func openTerminal() (io.ReadCloser, error) {
+	tty, err := os.Open("/dev/tty")
+	if err != nil {
+		return nil, err
+	}
+	if !isTerminal(tty) {
+		_ = tty.Close()
+		return nil, errors.New("/dev/tty is not a terminal")
+	}
+	return tty, nil
+}
+
+// withTerminal returns a copy of the context whose prompts read from the
+// controlling terminal. It fails when prompts are disabled or no terminal
+// is available.
+func (c *commandContext) withTerminal() (*commandContext, io.Closer, error) {
+	if c.global.NoInput || c.deps.Terminal == nil {
+		return nil, nil, errors.New("no terminal available")
+	}
+	tty, err := c.deps.Terminal()
+	if err != nil {
+		return nil, nil, err
+	}
+	ttyCtx := *c
+	ttyCtx.deps.In = tty
+	ttyCtx.deps.Interactive = true
+	return &ttyCtx, tty, nil
+}
+
+func isTerminal(r io.Reader) bool {
+	file, ok := r.(*os.File)
+	return ok && term.IsTerminal(int(file.Fd()))
+}
+
+func (p *prompter) readLine() (string, error) {
+	line, err := p.in.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+		if errors.Is(err, io.EOF) {
+			return "", errors.New("prompt: no input")
+		}
+		return "", fmt.Errorf("prompt: %w", err)
+	}
+	return strings.TrimSpace(line), nil
+}
+
+func (p *prompter) confirm(question string) (bool, error) {
+	_, _ = fmt.Fprintf(p.out, "%s [y/N]: ", question)
+	line, err := p.readLine()
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(line) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func (p *prompter) text(label string) (string, error) {
+	for {
+		_, _ = fmt.Fprintf(p.out, "%s: ", label)
+		line, err := p.readLine()
+		if err != nil {
+			return "", err
+		}
+		if line != "" {
+			return line, nil
+		}
+	}
+}
+
+// pick shows a numbered list; typing text narrows it, a number selects.
+// With optional set, an empty answer skips the field and returns "".
+func (p *prompter) pick(label string, choices []promptChoice, optional bool) (string, error) {
+	if len(choices) == 0 {
+		if optional {
+			return "", nil
+		}
+		return "", fmt.Errorf("no %s to choose from", strings.ToLower(label))
+	}
+	hint := "number or text to filter"
+	if optional {
+		hint += ", empty to skip"
+	}
+
+	filtered := choices
+	for {
+		p.printChoices(filtered)
+		_, _ = fmt.Fprintf(p.out, "%s (%s): ", label, hint)
+		line, err := p.readLine()
+		if err != nil {
+			return "", err
+		}
+		if line == "" {
+			if optional {
+				return "", nil
+			}
+			filtered = choices
+			continue
+		}
+		if n, convErr := strconv.Atoi(line); convErr == nil {
+			if n >= 1 && n <= len(filtered) && n <= maxPromptChoices {
+				return filtered[n-1].Value, nil
+			}
+			_, _ = fmt.Fprintf(p.out, "Invalid choice %d\n", n)
+			continue
+		}
+		for _, choice := range choices {
+			if strings.EqualFold(choice.Label, line) {
+				return choice.Value, nil
+			}
+		}
+		matches := filterChoices(choices, line)
+		switch len(matches) {
+		case 0:
+			_, _ = fmt.Fprintf(p.out, "No matches for %q\n", line)
+			filtered = choices
+		case 1:
+			return matches[0].Value, nil
+		default:
+			filtered = matches
+		}
+	}
+}
+
+func (p *prompter) pickMany(label string, choices []promptChoice) ([]string, error) {
+	picked := []string{}
+	remaining := choices
+	for len(remaining) > 0 {
+		value, err := p.pick(label, remaining, true)
+		if err != nil {
+			return nil, err
+		}
+		if value == "" {
+			break
+		}
+		picked = append(picked, value)
+		next := make([]promptChoice, 0, len(remaining)-1)
+		for _, choice := range remaining {
+			if choice.Value != value {
+				next = append(next, choice)
+			}
+		}
+		remaining = next
+	}
+	return picked, nil
+}
+
+func (p *prompter) printChoices(choices []promptChoice) {
+	for i, choice := range choices {
+		if i == maxPromptChoices {
+			_, _ = fmt.Fprintf(p.out, "  ... %d more, type to filter\n", len(choices)-maxPromptChoices)
+			break
+		}
+		_, _ = fmt.Fprintf(p.out, "  %d) %s\n", i+1, choice.Label)
+	}
+}
+
+func filterChoices(choices []promptChoice, query string) []promptChoice {
+	query = strings.ToLower(query)
+	out := []promptChoice{}
+	for _, choice := range choices {
+		if strings.Contains(strings.ToLower(choice.Label), query) {
+			out = append(out, choice)
+		}
+	}
+	return out
+}
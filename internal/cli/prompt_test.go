@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func TestPromptPickFiltersAndSelects(t *testing.T) {
+	choices := []promptChoice{
+		{Label: "Backlog", Value: "s1"},
+		{Label: "Todo", Value: "s2"},
+		{Label: "In Progress", Value: "s3"},
+		{Label: "In Review", Value: "s4"},
+	}
+	var out bytes.Buffer
+	p := &prompter{in: bufio.NewReader(strings.NewReader("in\n2\n")), out: &out}
+
+	value, err := p.pick("State", choices, false)
+	if err != nil {
+		t.Fatalf("pick: %v", err)
+	}
+	if value != "s4" {
+		t.Fatalf("expected s4, got %q", value)
+	}
+	if !strings.Contains(out.String(), "2) In Review") {
+		t.Fatalf("expected filtered list, got %q", out.String())
+	}
+}
+
+func TestPromptPickOptionalSkipsOnEmpty(t *testing.T) {
+	p := &prompter{in: bufio.NewReader(strings.NewReader("\n")), out: &bytes.Buffer{}}
+	value, err := p.pick("Project", []promptChoice{{Label: "Web", Value: "p1"}}, true)
+	if err != nil {
+		t.Fatalf("pick: %v", err)
+	}
+	if value != "" {
+		t.Fatalf("expected skip, got %q", value)
+	}
+}
+
+func newPromptTestAPI() *fakeAPI {
+	return &fakeAPI{
+		teams:  []linear.Team{{ID: "t1", Key: "ENG", Name: "Engineering"}, {ID: "t2", Key: "OPS", Name: "Operations"}},
+		users:  []linear.User{{ID: "user-1", Name: "Ada", Email: "ada@example.com"}},
+		states: map[string][]linear.WorkflowState{"team-OPS": {{ID: "state-todo", Name: "Todo"}}},
+		labels: map[string][]linear.Label{"team-OPS": {{ID: "label-bug", Name: "bug"}, {ID: "label-ui", Name: "ui"}}},
+	}
+}
+
+func TestIssueCreatePromptsForMissingFields(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := newPromptTestAPI()
+	deps, _, errOut := newTestDeps(api)
+	deps.Interactive = true
+	deps.In = strings.NewReader("ops\nBroken deploy\ntodo\n\nbug\n\n")
+
+	code := ExecuteWith(deps, []string{"issue", "create"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.creates) != 1 {
+		t.Fatalf("expected 1 create, got %d", len(api.creates))
+	}
+	input := api.creates[0]
+	if input.TeamID != "team-OPS" || input.Title != "Broken deploy" || input.StateID != "team-OPS/state-state-todo" || input.AssigneeID != "" {
+		t.Fatalf("unexpected input: %+v", input)
+	}
+	if len(input.LabelIDs) != 1 || input.LabelIDs[0] != "label-label-bug" {
+		t.Fatalf("unexpected labels: %v", input.LabelIDs)
+	}
+}
+
+func TestIssueCreateWithoutTeamFailsWithNoInput(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := newPromptTestAPI()
+	deps, _, _ := newTestDeps(api)
+	deps.Interactive = true
+
+	code := ExecuteWith(deps, []string{"--no-input", "issue", "create", "--title", "x"})
+	if code != 2 {
+		t.Fatalf("expected exit 2, got %d", code)
+	}
+}
+
+func TestIssueCreateRejectsStdinDescriptionWhenPrompting(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := newPromptTestAPI()
+	deps, _, errOut := newTestDeps(api)
+	deps.Interactive = true
+	deps.In = strings.NewReader("body from stdin")
+
+	if code := ExecuteWith(deps, []string{"issue", "create", "--description", "-"}); code != 2 {
+		t.Fatalf("expected exit 2, got %d", code)
+	}
+	if !strings.Contains(errOut.String(), "--description -") || len(api.creates) != 0 {
+		t.Fatalf("unexpected result: %q, %d creates", errOut.String(), len(api.creates))
+	}
+
+	code := ExecuteWith(deps, []string{"issue", "create", "--team", "OPS", "--title", "x", "--description", "-"})
+	if code != 0 {
+		t.Fatalf("expected exit 0 without prompts, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.creates) != 1 || api.creates[0].Description != "body from stdin" {
+		t.Fatalf("unexpected creates %+v", api.creates)
+	}
+}
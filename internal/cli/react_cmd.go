@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+type ReactCmd struct {
+	Args    []string `arg:"" name:"target" help:"Issue ID and emoji, or only the emoji with --comment"`
+	Comment string   `help:"React to this comment instead of an issue"`
+	Remove  bool     `help:"Remove your reaction instead of adding it"`
+}
+
+type reactionResult struct {
+	ID      string `json:"id"`
+	Emoji   string `json:"emoji"`
+	Target  string `json:"target"`
+	Action  string `json:"action"`
+	IssueID string `json:"issue_id,omitempty"`
+	Comment string `json:"comment_id,omitempty"`
+}
+
+func (c *ReactCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	issueRef, emojiArg, err := c.target()
+	if err != nil {
+		return exitError(2, err)
+	}
+	emoji, err := linear.ParseEmoji(emojiArg)
+	if err != nil {
+		return exitError(2, err)
+	}
+
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+
+	result := reactionResult{Emoji: emoji, Action: "added"}
+	var existing []linear.Reaction
+	if c.Comment != "" {
+		comment, err := client.Comment(ctx, c.Comment)
+		if err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		result.Comment = comment.ID
+		result.Target = "comment " + comment.ID
+		existing = comment.Reactions
+	} else {
+		issue, err := client.Issue(ctx, issueRef)
+		if err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		result.IssueID = issue.ID
+		result.Target = issue.Identifier
+		existing = issue.Reactions
+	}
+
+	if c.Remove {
+		me, err := client.Me(ctx)
+		if err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		reactionID := ""
+		for _, reaction := range existing {
+			if reaction.Emoji == emoji && reaction.UserID == me.ID {
+				reactionID = reaction.ID
+				break
+			}
+		}
+		if reactionID == "" {
+			return exitError(4, fmt.Errorf("no %s reaction of yours on %s", linear.EmojiSymbol(emoji), result.Target))
+		}
+		if err := client.ReactionDelete(ctx, reactionID); err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		result.ID = reactionID
+		result.Action = "removed"
+	} else {
+		reaction, err := client.ReactionCreate(ctx, result.IssueID, result.Comment, emoji)
+		if err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		result.ID = reaction.ID
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(result)
+	}
+	if result.Action == "removed" {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Removed %s from %s\n", linear.EmojiSymbol(emoji), result.Target)
+	} else {
+		_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Reacted %s to %s\n", linear.EmojiSymbol(emoji), result.Target)
+	}
+	return nil
+}
+
+func (c *ReactCmd) target() (string, string, error) {
+	switch {
+	case c.Comment != "" && len(c.Args) == 1:
+		return "", c.Args[0], nil
+	case c.Comment != "":
+		return "", "", errors.New("with --comment, pass only the emoji")
+	case len(c.Args) == 2:
+		return c.Args[0], c.Args[1], nil
+	default:
+		return "", "", errors.New("usage: linear react <issue-id> <emoji> or linear react --comment <comment-id> <emoji>")
+	}
+}
+
+// summarizeReactions groups reactions by emoji in first-seen order, e.g.
+// "👍 2  👀 1".
+func summarizeReactions(reactions []linear.Reaction) string {
+	counts := map[string]int{}
+	order := []string{}
+	for _, reaction := range reactions {
+		if counts[reaction.Emoji] == 0 {
+			order = append(order, reaction.Emoji)
+		}
+		counts[reaction.Emoji]++
+	}
+	parts := make([]string, 0, len(order))
+	for _, emoji := range order {
+		parts = append(parts, fmt.Sprintf("%s %d", linear.EmojiSymbol(emoji), counts[emoji]))
+	}
+	return strings.Join(parts, "  ")
+}
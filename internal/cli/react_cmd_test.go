@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func newReactTestAPI() *fakeAPI {
+	api := newFakeAPI(linear.IssueDetail{ID: "issue-1", Identifier: "ENG-1", Title: "Ack me", Reactions: []linear.Reaction{
+		{ID: "r-other", Emoji: "+1", UserID: "user-other"},
+		{ID: "r-mine", Emoji: "+1", UserID: "user-me"},
+	}})
+	api.commentsByID = map[string]linear.Comment{"c1": {ID: "c1", Body: "Looks good"}}
+	api.issueComments = []linear.Comment{{ID: "c1", Body: "Shipped", UserName: "Ada", Reactions: []linear.Reaction{
+		{ID: "r1", Emoji: "tada"}, {ID: "r2", Emoji: "eyes"}, {ID: "r3", Emoji: "tada"},
+	}}}
+	return api
+}
+
+func TestReact(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		code  int
+		check func(t *testing.T, api *fakeAPI, c *cliTest)
+	}{
+		{
+			name: "adds issue reaction",
+			args: []string{"react", "ENG-1", ":thumbsup:"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if len(api.reactions) != 1 || api.reactions[0] != (fakeReaction{IssueID: "issue-1", Emoji: "+1"}) {
+					t.Fatalf("unexpected reactions %+v", api.reactions)
+				}
+				if c.out.String() != "Reacted 👍 to ENG-1\n" {
+					t.Fatalf("unexpected output %q", c.out.String())
+				}
+			},
+		},
+		{
+			name: "adds comment reaction",
+			args: []string{"react", "--comment", "c1", ":eyes:"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if len(api.reactions) != 1 || api.reactions[0] != (fakeReaction{CommentID: "c1", Emoji: "eyes"}) {
+					t.Fatalf("unexpected reactions %+v", api.reactions)
+				}
+				if c.out.String() != "Reacted 👀 to comment c1\n" {
+					t.Fatalf("unexpected output %q", c.out.String())
+				}
+			},
+		},
+		{
+			name: "remove deletes own reaction",
+			args: []string{"react", "ENG-1", "+1", "--remove"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.removedReactions) != 1 || api.removedReactions[0] != "r-mine" {
+					t.Fatalf("expected own reaction to be removed, got %v", api.removedReactions)
+				}
+			},
+		},
+		{name: "remove without a matching reaction", args: []string{"react", "ENG-1", ":eyes:", "--remove"}, code: 4},
+		{name: "rejects text", args: []string{"react", "ENG-1", "not an emoji"}, code: 2},
+		{name: "requires a target", args: []string{"react", ":+1:"}, code: 2},
+		{name: "rejects issue and comment", args: []string{"react", "--comment", "c1", "ENG-1", ":+1:"}, code: 2},
+		{
+			name: "issue view lists reactions",
+			args: []string{"issue", "view", "ENG-1", "--comments"},
+			check: func(t *testing.T, _ *fakeAPI, c *cliTest) {
+				if !strings.Contains(c.out.String(), "Reactions: 👍 2\n") {
+					t.Fatalf("expected issue reactions, got:\n%s", c.out.String())
+				}
+				if !strings.Contains(c.out.String(), "- Ada (): Shipped\n  Reactions: 🎉 2  👀 1\n") {
+					t.Fatalf("expected comment reactions, got:\n%s", c.out.String())
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newReactTestAPI()
+			c := newCLITest(t, api)
+			c.run(tt.code, tt.args...)
+			if tt.check != nil {
+				tt.check(t, api, c)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+type IssueRelateCmd struct {
+	IssueID     string `arg:"" name:"issue-id" help:"Issue ID"`
+	Blocks      string `help:"Comma-separated issue IDs or keys this issue blocks"`
+	BlockedBy   string `name:"blocked-by" help:"Comma-separated issue IDs or keys blocking this issue"`
+	Related     string `help:"Comma-separated issue IDs or keys related to this issue"`
+	DuplicateOf string `name:"duplicate-of" help:"Issue ID or key this issue duplicates"`
+}
+
+type IssueUnrelateCmd struct {
+	IssueID     string `arg:"" name:"issue-id" help:"Issue ID"`
+	Blocks      string `help:"Comma-separated issue IDs or keys to remove from blocks"`
+	BlockedBy   string `name:"blocked-by" help:"Comma-separated issue IDs or keys to remove from blocked-by"`
+	Related     string `help:"Comma-separated issue IDs or keys to remove from related"`
+	DuplicateOf string `name:"duplicate-of" help:"Issue ID or key to remove from duplicate-of"`
+}
+
+func (c *IssueRelateCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	return runRelationChange(ctx, cmdCtx, c.IssueID, issueRelationFlags{
+		Blocks:      c.Blocks,
+		BlockedBy:   c.BlockedBy,
+		Related:     c.Related,
+		DuplicateOf: c.DuplicateOf,
+	})
+}
+
+func (c *IssueUnrelateCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	return runRelationChange(ctx, cmdCtx, c.IssueID, issueRelationFlags{
+		RemoveBlocks:      c.Blocks,
+		RemoveBlockedBy:   c.BlockedBy,
+		RemoveRelated:     c.Related,
+		RemoveDuplicateOf: c.DuplicateOf,
+	})
+}
+
+func runRelationChange(ctx context.Context, cmdCtx *commandContext, issueRef string, flags issueRelationFlags) error {
+	if flags.isEmpty() {
+		return exitError(2, errors.New("at least one of --blocks, --blocked-by, --related, or --duplicate-of is required"))
+	}
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	issueID, err := client.ResolveIssueID(ctx, issueRef)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	if err := applyIssueRelations(ctx, client, issueID, flags, true); err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+
+	relations, err := client.IssueRelations(ctx, issueID, 200)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	links := relations.Links()
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(links)
+	}
+	rows := make([][]string, 0, len(links))
+	for _, link := range links {
+		rows = append(rows, []string{relationLabel(link.Type), link.Issue.Identifier, link.Issue.Title, link.Issue.State})
+	}
+	return out.PrintTable([]string{"Relation", "ID", "Title", "State"}, rows)
+}
+
+func relationLabel(linkType string) string {
+	switch linkType {
+	case linear.RelationRelated, linear.RelationSimilar:
+		return linkType + " to"
+	}
+	return strings.ReplaceAll(linkType, "_", " ")
+}
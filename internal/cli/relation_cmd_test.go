@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func TestIssueRelateCreatesMissingRelations(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{relations: linear.IssueRelationSet{
+		InverseRelations: []linear.IssueRelation{
+			{ID: "rel-1", IssueID: "ENG-2", RelatedIssueID: "ENG-1", Type: "related", Issue: linear.IssueRef{Identifier: "ENG-2", Title: "Other", State: "Todo"}},
+		},
+	}}
+	deps, out, errOut := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"issue", "relate", "ENG-1", "--related", "ENG-2", "--duplicate-of", "ENG-3", "--blocked-by", "ENG-4"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.createdRelations) != 2 {
+		t.Fatalf("expected 2 new relations, got %+v", api.createdRelations)
+	}
+	blocked := api.createdRelations[0]
+	if blocked.Type != "blocks" || blocked.IssueID != "ENG-4" || blocked.RelatedIssueID != "ENG-1" {
+		t.Fatalf("unexpected blocked-by relation: %+v", blocked)
+	}
+	duplicate := api.createdRelations[1]
+	if duplicate.Type != "duplicate" || duplicate.IssueID != "ENG-1" || duplicate.RelatedIssueID != "ENG-3" {
+		t.Fatalf("unexpected duplicate relation: %+v", duplicate)
+	}
+	if !strings.Contains(out.String(), "related to  ENG-2") {
+		t.Fatalf("expected relation table, got %q", out.String())
+	}
+}
+
+func TestIssueUnrelateDeletesMatchingRelation(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{relations: linear.IssueRelationSet{
+		Relations: []linear.IssueRelation{
+			{ID: "rel-1", IssueID: "ENG-1", RelatedIssueID: "ENG-2", Type: "duplicate"},
+			{ID: "rel-2", IssueID: "ENG-1", RelatedIssueID: "ENG-2", Type: "blocks"},
+		},
+	}}
+	deps, _, errOut := newTestDeps(api)
+
+	code := ExecuteWith(deps, []string{"issue", "unrelate", "ENG-1", "--duplicate-of", "ENG-2"})
+	if code != 0 {
+		t.Fatalf("expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if len(api.deletedRelations) != 1 || api.deletedRelations[0] != "rel-1" {
+		t.Fatalf("expected rel-1 deleted, got %v", api.deletedRelations)
+	}
+}
+
+func TestIssueRelateRequiresFlags(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	deps, _, _ := newTestDeps(&fakeAPI{})
+	if code := ExecuteWith(deps, []string{"issue", "relate", "ENG-1"}); code != 2 {
+		t.Fatalf("expected exit 2, got %d", code)
+	}
+}
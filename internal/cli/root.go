@@ -7,11 +7,18 @@ type CLI struct {
 
 	Version kong.VersionFlag `help:"Print version and exit"`
 
-	Auth   AuthCmd   `cmd:"" help:"Manage authentication"`
-	Whoami WhoamiCmd `cmd:"" help:"Show current Linear user"`
-	Issue  IssueCmd  `cmd:"" help:"Manage issues"`
-	Cycle  CycleCmd  `cmd:"" help:"Manage cycles"`
-	Team   TeamCmd   `cmd:"" help:"Manage teams"`
+	Auth    AuthCmd    `cmd:"" help:"Manage authentication"`
+	Whoami  WhoamiCmd  `cmd:"" help:"Show current Linear user"`
+	Issue   IssueCmd   `cmd:"" help:"Manage issues"`
+	Cycle   CycleCmd   `cmd:"" help:"Manage cycles"`
+	Team    TeamCmd    `cmd:"" help:"Manage teams"`
+	View    ViewCmd    `cmd:"" help:"Manage custom views"`
+	Search  SearchCmd  `cmd:"" help:"Manage saved issue searches"`
+	Comment CommentCmd `cmd:"" help:"Manage issue comments"`
+	React   ReactCmd   `cmd:"" help:"Add or remove an emoji reaction on an issue or comment"`
+	Batch   BatchCmd   `cmd:"" help:"Run a file of issue operations"`
+	History HistoryCmd `cmd:"" help:"List recent operations from the local journal"`
+	Undo    UndoCmd    `cmd:"" help:"Undo a journaled operation or run"`
 }
 
 func outputFor(ctx *commandContext) output {
@@ -1,16 +1,20 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/alecthomas/kong"
 
 	"github.com/duailibe/linear-cli/internal/auth"
+	"github.com/duailibe/linear-cli/internal/config"
+	"github.com/duailibe/linear-cli/internal/journal"
 	"github.com/duailibe/linear-cli/internal/linear"
 )
 
@@ -24,6 +28,22 @@ func Run(args []string, in io.Reader, out io.Writer, errOut io.Writer) int {
 		_, _ = errOut.Write([]byte(err.Error() + "\n"))
 		return 1
 	}
+	searchesPath, err := config.DefaultSearchesPath()
+	if err != nil {
+		_, _ = errOut.Write([]byte(err.Error() + "\n"))
+		return 1
+	}
+	journalPath, err := journal.DefaultPath()
+	if err != nil {
+		_, _ = errOut.Write([]byte(err.Error() + "\n"))
+		return 1
+	}
+	workDir, _ := os.Getwd()
+	templateDirs, err := config.DefaultTemplateDirs(workDir)
+	if err != nil {
+		_, _ = errOut.Write([]byte(err.Error() + "\n"))
+		return 1
+	}
 
 	deps := Dependencies{
 		In:        in,
@@ -31,7 +51,14 @@ func Run(args []string, in io.Reader, out io.Writer, errOut io.Writer) int {
 		Err:       errOut,
 		Now:       time.Now,
 		AuthStore: auth.NewStore(storePath),
+		Searches:  config.NewSearchStore(searchesPath),
+		Templates: config.NewTemplateStore(templateDirs...),
+		Journal:   journal.NewStore(journalPath),
 		NewClient: linear.NewClient,
+		Editor:    runEditor,
+		Terminal:  openTerminal,
+
+		Interactive: isTerminal(in),
 	}
 
 	return ExecuteWith(deps, args)
@@ -70,10 +97,28 @@ func ExecuteWith(deps Dependencies, args []string) (code int) {
 		return handleExit(deps, wrapParseError(err))
 	}
 
+	cmdCtx := &commandContext{
+		deps:    deps,
+		global:  &cli.GlobalOptions,
+		run:     journal.NewID(),
+		command: commandName(kctx),
+	}
+	var buffered *bytes.Buffer
+	if cli.DryRun {
+		buffered = &bytes.Buffer{}
+		cmdCtx.deps.Out = buffered
+		cmdCtx.mutations = &linear.MutationLog{}
+	}
 	kctx.BindTo(context.Background(), (*context.Context)(nil))
-	kctx.Bind(&commandContext{deps: deps, global: &cli.GlobalOptions})
+	kctx.Bind(cmdCtx)
 
-	if err := kctx.Run(); err != nil {
+	err = kctx.Run()
+	if buffered != nil {
+		if printErr := printDryRun(deps, cmdCtx, buffered); printErr != nil && err == nil {
+			err = printErr
+		}
+	}
+	if err != nil {
 		return handleExit(deps, err)
 	}
 	return 0
@@ -119,3 +164,13 @@ func handleExit(deps Dependencies, err error) int {
 	_, _ = fmt.Fprintf(deps.Err, "%v\n", err)
 	return 1
 }
+
+func commandName(kctx *kong.Context) string {
+	words := []string{}
+	for _, word := range strings.Fields(kctx.Command()) {
+		if !strings.HasPrefix(word, "<") {
+			words = append(words, word)
+		}
+	}
+	return strings.Join(words, " ")
+}
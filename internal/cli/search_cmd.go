@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/duailibe/linear-cli/internal/config"
+)
+
+type SearchCmd struct {
+	Save   SearchSaveCmd   `cmd:"" help:"Save 'issue list' arguments under a name"`
+	Run    SearchRunCmd    `cmd:"" help:"Run a saved search"`
+	List   SearchListCmd   `cmd:"" help:"List saved searches"`
+	Delete SearchDeleteCmd `cmd:"" help:"Delete a saved search"`
+}
+
+type SearchSaveCmd struct {
+	Name string   `arg:"" help:"Search name"`
+	Args []string `arg:"" optional:"" passthrough:"" help:"'issue list' arguments (after --); use {name} for placeholders"`
+}
+
+type SearchRunCmd struct {
+	Name string            `arg:"" help:"Search name"`
+	Vars map[string]string `name:"var" help:"Placeholder value (name=value)"`
+	Args []string          `arg:"" optional:"" passthrough:"" help:"Extra 'issue list' arguments (after --)"`
+}
+
+type SearchListCmd struct{}
+
+type SearchDeleteCmd struct {
+	Name string `arg:"" help:"Search name"`
+}
+
+func (c *SearchSaveCmd) Run(cmdCtx *commandContext) error {
+	store, err := searchStore(cmdCtx)
+	if err != nil {
+		return exitError(1, err)
+	}
+	name := strings.TrimSpace(c.Name)
+	if name == "" {
+		return exitError(2, errors.New("search name is required"))
+	}
+	args := trimPassthrough(c.Args)
+	if len(args) == 0 {
+		return exitError(2, errors.New("search arguments are required (pass them after --)"))
+	}
+	search := config.SavedSearch{Name: name, Args: args, SavedAt: cmdCtx.deps.Now()}
+	if len(search.Params()) == 0 {
+		if _, err := parseIssueListArgs(args); err != nil {
+			return exitError(2, err)
+		}
+	}
+	if err := store.Save(search); err != nil {
+		return exitError(1, err)
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(search)
+	}
+	_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Saved search %s\n", search.Name)
+	return nil
+}
+
+func (c *SearchRunCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	store, err := searchStore(cmdCtx)
+	if err != nil {
+		return exitError(1, err)
+	}
+	search, ok, err := store.Get(c.Name)
+	if err != nil {
+		return exitError(1, err)
+	}
+	if !ok {
+		return exitError(4, fmt.Errorf("saved search %q not found", c.Name))
+	}
+	args, err := search.Expand(c.Vars)
+	if err != nil {
+		return exitError(2, err)
+	}
+	args = append(args, trimPassthrough(c.Args)...)
+	cmd, err := parseIssueListArgs(args)
+	if err != nil {
+		return exitError(2, err)
+	}
+	return cmd.Run(ctx, cmdCtx)
+}
+
+func (c *SearchListCmd) Run(cmdCtx *commandContext) error {
+	store, err := searchStore(cmdCtx)
+	if err != nil {
+		return exitError(1, err)
+	}
+	searches, err := store.List()
+	if err != nil {
+		return exitError(1, err)
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(searches)
+	}
+	rows := make([][]string, 0, len(searches))
+	for _, search := range searches {
+		rows = append(rows, []string{search.Name, strings.Join(search.Args, " "), strings.Join(search.Params(), ", ")})
+	}
+	return out.PrintTable([]string{"Name", "Args", "Params"}, rows)
+}
+
+func (c *SearchDeleteCmd) Run(cmdCtx *commandContext) error {
+	store, err := searchStore(cmdCtx)
+	if err != nil {
+		return exitError(1, err)
+	}
+	deleted, err := store.Delete(c.Name)
+	if err != nil {
+		return exitError(1, err)
+	}
+	if !deleted {
+		return exitError(4, fmt.Errorf("saved search %q not found", c.Name))
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(map[string]any{"deleted": true, "name": c.Name})
+	}
+	_, _ = fmt.Fprintf(cmdCtx.deps.Out, "Deleted search %s\n", c.Name)
+	return nil
+}
+
+func searchStore(cmdCtx *commandContext) (*config.SearchStore, error) {
+	if cmdCtx.deps.Searches == nil {
+		return nil, errors.New("no search store configured")
+	}
+	return cmdCtx.deps.Searches, nil
+}
+
+func trimPassthrough(args []string) []string {
+	if len(args) > 0 && args[0] == "--" {
+		return args[1:]
+	}
+	return args
+}
+
+func parseIssueListArgs(args []string) (*IssueListCmd, error) {
+	cmd := &IssueListCmd{}
+	parser, err := kong.New(cmd,
+		kong.Name("linear issue list"),
+		kong.NoDefaultHelp(),
+		kong.Writers(io.Discard, io.Discard),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := parser.Parse(args); err != nil {
+		return nil, fmt.Errorf("invalid issue list arguments: %w", err)
+	}
+	return cmd, nil
+}
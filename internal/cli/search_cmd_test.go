@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/config"
+)
+
+func TestSearchSaveAndRunExpandsPlaceholders(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	api := &fakeAPI{}
+	deps, out, errOut := newTestDeps(api)
+	deps.Searches = config.NewSearchStore(filepath.Join(t.TempDir(), "searches.json"))
+
+	code := ExecuteWith(deps, []string{"search", "save", "triage", "--", "--team", "{team}", "--state", "Triage", "--unassigned"})
+	if code != 0 {
+		t.Fatalf("save: expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+
+	code = ExecuteWith(deps, []string{"search", "run", "triage", "--var", "team=ENG"})
+	if code != 0 {
+		t.Fatalf("run: expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	if api.issuesFilter == nil {
+		t.Fatalf("expected Issues to be called")
+	}
+	if api.issuesFilter.TeamID != "team-ENG" {
+		t.Fatalf("expected team-ENG, got %s", api.issuesFilter.TeamID)
+	}
+	if api.issuesFilter.StateID != "team-ENG/state-Triage" {
+		t.Fatalf("expected resolved state, got %s", api.issuesFilter.StateID)
+	}
+	if !api.issuesFilter.Unassigned {
+		t.Fatalf("expected unassigned filter")
+	}
+	if !strings.Contains(out.String(), "Saved search triage") {
+		t.Fatalf("expected save confirmation, got %q", out.String())
+	}
+}
+
+func TestSearchRunMissingPlaceholder(t *testing.T) {
+	t.Setenv("LINEAR_API_KEY", "test-key")
+	deps, _, errOut := newTestDeps(&fakeAPI{})
+	deps.Searches = config.NewSearchStore(filepath.Join(t.TempDir(), "searches.json"))
+
+	if code := ExecuteWith(deps, []string{"search", "save", "mine", "--", "--team", "{team}"}); code != 0 {
+		t.Fatalf("save: expected exit 0, got %d (stderr: %s)", code, errOut.String())
+	}
+	code := ExecuteWith(deps, []string{"search", "run", "mine"})
+	if code != 2 {
+		t.Fatalf("expected exit 2, got %d", code)
+	}
+	if !strings.Contains(errOut.String(), "missing value for team") {
+		t.Fatalf("expected missing placeholder error, got %q", errOut.String())
+	}
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+type IssueSubscribeCmd struct {
+	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
+	User    string `help:"Comma-separated users to subscribe (me, id, or email)" default:"me"`
+}
+
+type IssueUnsubscribeCmd struct {
+	IssueID string `arg:"" name:"issue-id" help:"Issue ID"`
+	User    string `help:"Comma-separated users to unsubscribe (me, id, or email)" default:"me"`
+}
+
+type subscriptionResult struct {
+	IssueID    string `json:"issue_id"`
+	Identifier string `json:"identifier"`
+	User       string `json:"user"`
+	UserID     string `json:"user_id"`
+	Action     string `json:"action"`
+}
+
+func (c *IssueSubscribeCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	return runSubscription(ctx, cmdCtx, c.IssueID, c.User, "subscribed", func(client linear.API, issueID, userID string) error {
+		return client.IssueSubscribe(ctx, issueID, userID)
+	})
+}
+
+func (c *IssueUnsubscribeCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	return runSubscription(ctx, cmdCtx, c.IssueID, c.User, "unsubscribed", func(client linear.API, issueID, userID string) error {
+		return client.IssueUnsubscribe(ctx, issueID, userID)
+	})
+}
+
+func runSubscription(ctx context.Context, cmdCtx *commandContext, issueRef, users, action string, apply func(linear.API, string, string) error) error {
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	issue, err := client.Issue(ctx, issueRef)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+
+	// Resolve every user before changing anything so a typo doesn't leave the
+	// issue half-updated.
+	results := []subscriptionResult{}
+	for _, user := range splitComma(users) {
+		userID, err := client.ResolveUserID(ctx, user)
+		if err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+		results = append(results, subscriptionResult{
+			IssueID:    issue.ID,
+			Identifier: issue.Identifier,
+			User:       user,
+			UserID:     userID,
+			Action:     action,
+		})
+	}
+	for _, result := range results {
+		if err := apply(client, issue.ID, result.UserID); err != nil {
+			return exitError(mapErrorToExitCode(err), err)
+		}
+	}
+
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(results)
+	}
+	rows := make([][]string, 0, len(results))
+	for _, result := range results {
+		rows = append(rows, []string{result.Identifier, result.User, result.Action})
+	}
+	return out.PrintTable([]string{"ID", "User", "Action"}, rows)
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/duailibe/linear-cli/internal/linear"
+)
+
+func TestIssueSubscribe(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		code  int
+		check func(t *testing.T, api *fakeAPI, c *cliTest)
+	}{
+		{
+			name: "defaults to me",
+			args: []string{"issue", "subscribe", "ENG-1"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if strings.Join(api.subscribed, ",") != "issue-1/user-me" {
+					t.Fatalf("unexpected subscriptions %v", api.subscribed)
+				}
+			},
+		},
+		{
+			name: "resolves all users first",
+			args: []string{"issue", "subscribe", "ENG-1", "--user", "alice@x.com,missing@example.com"},
+			code: 4,
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if len(api.subscribed) != 0 {
+					t.Fatalf("expected no subscriptions after a failed lookup, got %v", api.subscribed)
+				}
+			},
+		},
+		{
+			name: "unsubscribes every user",
+			args: []string{"issue", "unsubscribe", "ENG-1", "--user", "alice@x.com,bob@x.com"},
+			check: func(t *testing.T, api *fakeAPI, c *cliTest) {
+				if strings.Join(api.unsubscribed, ",") != "issue-1/user-alice,issue-1/user-bob" {
+					t.Fatalf("unexpected unsubscriptions %v", api.unsubscribed)
+				}
+				if !strings.Contains(c.out.String(), "bob@x.com") || !strings.Contains(c.out.String(), "unsubscribed") {
+					t.Fatalf("unexpected output %q", c.out.String())
+				}
+			},
+		},
+		{
+			name: "list filters by subscriber",
+			args: []string{"issue", "list", "--subscriber", "me"},
+			check: func(t *testing.T, api *fakeAPI, _ *cliTest) {
+				if api.issuesFilter == nil || api.issuesFilter.SubscriberID != "user-me" {
+					t.Fatalf("expected subscriber filter, got %+v", api.issuesFilter)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI(linear.IssueDetail{ID: "issue-1", Identifier: "ENG-1"})
+			c := newCLITest(t, api)
+			c.run(tt.code, tt.args...)
+			tt.check(t, api, c)
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/duailibe/linear-cli/internal/auth"
+	"github.com/duailibe/linear-cli/internal/config"
+	"github.com/duailibe/linear-cli/internal/journal"
 	"github.com/duailibe/linear-cli/internal/linear"
 )
 
@@ -16,7 +18,14 @@ type Dependencies struct {
 	Err       io.Writer
 	Now       func() time.Time
 	AuthStore *auth.Store
+	Searches  *config.SearchStore
+	Templates *config.TemplateStore
+	Journal   *journal.Store
 	NewClient func(token string, timeout time.Duration) linear.API
+	Editor    func(path string) error
+	Terminal  func() (io.ReadCloser, error)
+
+	Interactive bool
 }
 
 type GlobalOptions struct {
@@ -26,6 +35,7 @@ type GlobalOptions struct {
 	Verbose bool          `short:"v" help:"enable verbose diagnostics"`
 	NoInput bool          `name:"no-input" help:"disable interactive prompts"`
 	Yes     bool          `short:"y" help:"assume yes for confirmations"`
+	DryRun  bool          `name:"dry-run" help:"print mutations instead of sending them"`
 	Timeout time.Duration `help:"API request timeout" default:"10s"`
 	APIKey  string        `name:"api-key" help:"Linear API key (overrides env and stored auth)"`
 }
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+)
+
+type ViewCmd struct {
+	List ViewListCmd `cmd:"" help:"List custom views"`
+}
+
+type ViewListCmd struct{}
+
+func (c *ViewListCmd) Run(ctx context.Context, cmdCtx *commandContext) error {
+	client, err := cmdCtx.apiClient()
+	if err != nil {
+		return exitError(3, err)
+	}
+	views, err := client.CustomViews(ctx)
+	if err != nil {
+		return exitError(mapErrorToExitCode(err), err)
+	}
+	out := outputFor(cmdCtx)
+	if out.JSON {
+		return out.PrintJSON(views)
+	}
+	rows := make([][]string, 0, len(views))
+	for _, view := range views {
+		rows = append(rows, []string{view.ID, view.Name, view.TeamKey, fmt.Sprintf("%t", view.Shared)})
+	}
+	return out.PrintTable([]string{"ID", "Name", "Team", "Shared"}, rows)
+}
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func DefaultDir() (string, error) {
+	if base := os.Getenv("XDG_CONFIG_HOME"); base != "" {
+		return filepath.Join(base, "linear"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolve home dir: %w", err)
+	}
+
+	return filepath.Join(home, ".config", "linear"), nil
+}
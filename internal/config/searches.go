@@ -0,0 +1,184 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	searchesFileName = "searches.json"
+)
+
+type SavedSearch struct {
+	Name    string    `json:"name"`
+	Args    []string  `json:"args"`
+	SavedAt time.Time `json:"saved_at"`
+}
+
+type SearchStore struct {
+	Path string
+}
+
+type searchesFile struct {
+	Searches []SavedSearch `json:"searches"`
+}
+
+var placeholderRe = regexp.MustCompile(`\{([A-Za-z][A-Za-z0-9_-]*)\}`)
+
+func DefaultSearchesPath() (string, error) {
+	dir, err := DefaultDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, searchesFileName), nil
+}
+
+func NewSearchStore(path string) *SearchStore {
+	return &SearchStore{Path: path}
+}
+
+func (s *SearchStore) List() ([]SavedSearch, error) {
+	file, err := os.Open(s.Path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []SavedSearch{}, nil
+		}
+		return nil, fmt.Errorf("open searches file: %w", err)
+	}
+	defer file.Close()
+
+	var data searchesFile
+	if err := json.NewDecoder(file).Decode(&data); err != nil {
+		return nil, fmt.Errorf("decode searches file: %w", err)
+	}
+	if data.Searches == nil {
+		data.Searches = []SavedSearch{}
+	}
+	sort.Slice(data.Searches, func(i, j int) bool {
+		return data.Searches[i].Name < data.Searches[j].Name
+	})
+	return data.Searches, nil
+}
+
+func (s *SearchStore) Get(name string) (SavedSearch, bool, error) {
+	searches, err := s.List()
+	if err != nil {
+		return SavedSearch{}, false, err
+	}
+	for _, search := range searches {
+		if search.Name == name {
+			return search, true, nil
+		}
+	}
+	return SavedSearch{}, false, nil
+}
+
+func (s *SearchStore) Save(search SavedSearch) error {
+	if strings.TrimSpace(search.Name) == "" {
+		return errors.New("search name is empty")
+	}
+	searches, err := s.List()
+	if err != nil {
+		return err
+	}
+	replaced := false
+	for i := range searches {
+		if searches[i].Name == search.Name {
+			searches[i] = search
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		searches = append(searches, search)
+	}
+	return s.write(searches)
+}
+
+func (s *SearchStore) Delete(name string) (bool, error) {
+	searches, err := s.List()
+	if err != nil {
+		return false, err
+	}
+	kept := make([]SavedSearch, 0, len(searches))
+	for _, search := range searches {
+		if search.Name != name {
+			kept = append(kept, search)
+		}
+	}
+	if len(kept) == len(searches) {
+		return false, nil
+	}
+	return true, s.write(kept)
+}
+
+func (s *SearchStore) write(searches []SavedSearch) error {
+	dir := filepath.Dir(s.Path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+
+	tmp := s.Path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("write searches file: %w", err)
+	}
+
+	enc := json.NewEncoder(file)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(searchesFile{Searches: searches}); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("encode searches file: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close searches file: %w", err)
+	}
+
+	if err := os.Rename(tmp, s.Path); err != nil {
+		return fmt.Errorf("replace searches file: %w", err)
+	}
+
+	return nil
+}
+
+func (s SavedSearch) Params() []string {
+	seen := map[string]struct{}{}
+	params := []string{}
+	for _, arg := range s.Args {
+		for _, match := range placeholderRe.FindAllStringSubmatch(arg, -1) {
+			if _, ok := seen[match[1]]; ok {
+				continue
+			}
+			seen[match[1]] = struct{}{}
+			params = append(params, match[1])
+		}
+	}
+	return params
+}
+
+func (s SavedSearch) Expand(values map[string]string) ([]string, error) {
+	missing := []string{}
+	for _, param := range s.Params() {
+		if _, ok := values[param]; !ok {
+			missing = append(missing, param)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing value for %s", strings.Join(missing, ", "))
+	}
+	out := make([]string, 0, len(s.Args))
+	for _, arg := range s.Args {
+		out = append(out, placeholderRe.ReplaceAllStringFunc(arg, func(match string) string {
+			return values[match[1:len(match)-1]]
+		}))
+	}
+	return out, nil
+}
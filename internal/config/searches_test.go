@@ -0,0 +1,77 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDefaultDirXDG(t *testing.T) {
+	temp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", temp)
+
+	dir, err := DefaultDir()
+	if err != nil {
+		t.Fatalf("DefaultDir() error: %v", err)
+	}
+
+	expected := filepath.Join(temp, "linear")
+	if dir != expected {
+		t.Fatalf("expected %s, got %s", expected, dir)
+	}
+}
+
+func TestSearchStoreSaveGetDelete(t *testing.T) {
+	store := NewSearchStore(filepath.Join(t.TempDir(), "searches.json"))
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if err := store.Save(SavedSearch{Name: "triage", Args: []string{"--team", "ENG"}, SavedAt: now}); err != nil {
+		t.Fatalf("Save() error: %v", err)
+	}
+	if err := store.Save(SavedSearch{Name: "triage", Args: []string{"--team", "OPS"}, SavedAt: now}); err != nil {
+		t.Fatalf("Save() overwrite error: %v", err)
+	}
+
+	searches, err := store.List()
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(searches) != 1 {
+		t.Fatalf("expected 1 search, got %d", len(searches))
+	}
+	if searches[0].Args[1] != "OPS" {
+		t.Fatalf("expected overwritten args, got %v", searches[0].Args)
+	}
+
+	deleted, err := store.Delete("triage")
+	if err != nil {
+		t.Fatalf("Delete() error: %v", err)
+	}
+	if !deleted {
+		t.Fatalf("expected search to be deleted")
+	}
+	if _, ok, _ := store.Get("triage"); ok {
+		t.Fatalf("expected no search after delete")
+	}
+}
+
+func TestSavedSearchExpand(t *testing.T) {
+	search := SavedSearch{Args: []string{"--team", "{team}", "--search", "{term} in {team}"}}
+
+	params := search.Params()
+	if len(params) != 2 || params[0] != "team" || params[1] != "term" {
+		t.Fatalf("unexpected params: %v", params)
+	}
+
+	args, err := search.Expand(map[string]string{"team": "ENG", "term": "crash"})
+	if err != nil {
+		t.Fatalf("Expand() error: %v", err)
+	}
+	if args[1] != "ENG" || args[3] != "crash in ENG" {
+		t.Fatalf("unexpected expansion: %v", args)
+	}
+
+	if _, err := search.Expand(map[string]string{"team": "ENG"}); err == nil {
+		t.Fatalf("expected error for missing placeholder")
+	}
+}
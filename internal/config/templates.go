@@ -0,0 +1,147 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	templatesDirName     = "templates"
+	repoTemplatesDirName = ".linear"
+	templateExt          = ".md"
+)
+
+type TemplateDir struct {
+	Path   string
+	Source string
+}
+
+type Template struct {
+	Name   string `json:"name"`
+	Path   string `json:"path"`
+	Source string `json:"source"`
+}
+
+type TemplateStore struct {
+	Dirs []TemplateDir
+}
+
+type TemplateData struct {
+	Now         time.Time
+	CurrentUser func() (string, error)
+}
+
+func (d TemplateData) Date() string {
+	return d.Now.Format("2006-01-02")
+}
+
+func (d TemplateData) User() (string, error) {
+	if d.CurrentUser == nil {
+		return "", nil
+	}
+	return d.CurrentUser()
+}
+
+func DefaultTemplateDirs(workDir string) ([]TemplateDir, error) {
+	dirs := []TemplateDir{}
+	if workDir != "" {
+		dir := workDir
+		for {
+			candidate := filepath.Join(dir, repoTemplatesDirName, templatesDirName)
+			if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+				dirs = append(dirs, TemplateDir{Path: candidate, Source: "repo"})
+				break
+			}
+			parent := filepath.Dir(dir)
+			if parent == dir {
+				break
+			}
+			dir = parent
+		}
+	}
+	configDir, err := DefaultDir()
+	if err != nil {
+		return nil, err
+	}
+	dirs = append(dirs, TemplateDir{Path: filepath.Join(configDir, templatesDirName), Source: "config"})
+	return dirs, nil
+}
+
+func NewTemplateStore(dirs ...TemplateDir) *TemplateStore {
+	return &TemplateStore{Dirs: dirs}
+}
+
+func (s *TemplateStore) List() ([]Template, error) {
+	seen := map[string]struct{}{}
+	templates := []Template{}
+	for _, dir := range s.Dirs {
+		entries, err := os.ReadDir(dir.Path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("read templates dir: %w", err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != templateExt {
+				continue
+			}
+			name := strings.TrimSuffix(entry.Name(), templateExt)
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			templates = append(templates, Template{
+				Name:   name,
+				Path:   filepath.Join(dir.Path, entry.Name()),
+				Source: dir.Source,
+			})
+		}
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
+
+func (s *TemplateStore) Get(name string) (Template, bool, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return Template{}, false, nil
+	}
+	for _, dir := range s.Dirs {
+		path := filepath.Join(dir.Path, name+templateExt)
+		if _, err := os.Stat(path); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return Template{}, false, fmt.Errorf("stat template: %w", err)
+		}
+		return Template{Name: name, Path: path, Source: dir.Source}, true, nil
+	}
+	return Template{}, false, nil
+}
+
+func (t Template) Render(data TemplateData, promptValue func(name string) (string, error)) (string, error) {
+	raw, err := os.ReadFile(t.Path)
+	if err != nil {
+		return "", fmt.Errorf("read template: %w", err)
+	}
+	tmpl, err := template.New(t.Name).
+		Option("missingkey=error").
+		Funcs(template.FuncMap{"prompt": promptValue}).
+		Parse(string(raw))
+	if err != nil {
+		return "", fmt.Errorf("parse template %s: %w", t.Name, err)
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("render template %s: %w", t.Name, err)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDefaultTemplateDirsFindsRepoTemplates(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	root := t.TempDir()
+	repoDir := filepath.Join(root, ".linear", "templates")
+	if err := os.MkdirAll(repoDir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	nested := filepath.Join(root, "a", "b")
+	if err := os.MkdirAll(nested, 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	dirs, err := DefaultTemplateDirs(nested)
+	if err != nil {
+		t.Fatalf("DefaultTemplateDirs() error: %v", err)
+	}
+	if len(dirs) != 2 || dirs[0].Path != repoDir || dirs[0].Source != "repo" || dirs[1].Source != "config" {
+		t.Fatalf("unexpected dirs: %+v", dirs)
+	}
+}
+
+func TestTemplateStoreShadowsAndRenders(t *testing.T) {
+	repoDir := t.TempDir()
+	configDir := t.TempDir()
+	writeFile(t, filepath.Join(repoDir, "bug.md"), "---\ntitle: {{prompt \"summary\"}} ({{.Date}})\n---\n\nReported by {{.User}}\n")
+	writeFile(t, filepath.Join(configDir, "bug.md"), "shadowed")
+	writeFile(t, filepath.Join(configDir, "spike.md"), "spike")
+
+	store := NewTemplateStore(TemplateDir{Path: repoDir, Source: "repo"}, TemplateDir{Path: configDir, Source: "config"})
+	templates, err := store.List()
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(templates) != 2 || templates[0].Source != "repo" || templates[1].Name != "spike" {
+		t.Fatalf("unexpected templates: %+v", templates)
+	}
+
+	tmpl, ok, err := store.Get("bug")
+	if err != nil || !ok {
+		t.Fatalf("Get() = %v, %v", ok, err)
+	}
+	data := TemplateData{
+		Now:         time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC),
+		CurrentUser: func() (string, error) { return "Ada", nil },
+	}
+	text, err := tmpl.Render(data, func(name string) (string, error) {
+		return "Crash on " + name, nil
+	})
+	if err != nil {
+		t.Fatalf("Render() error: %v", err)
+	}
+	expected := "---\ntitle: Crash on summary (2026-03-01)\n---\n\nReported by Ada\n"
+	if text != expected {
+		t.Fatalf("expected %q, got %q", expected, text)
+	}
+
+	if _, ok, _ := store.Get("../bug"); ok {
+		t.Fatalf("expected path names to be rejected")
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,52 @@
+package journal
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStoreAppendAndList(t *testing.T) {
+	store := NewStore(filepath.Join(t.TempDir(), "nested", journalFileName))
+
+	entries, err := store.List()
+	if err != nil {
+		t.Fatalf("List() on missing file: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected no entries, got %d", len(entries))
+	}
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, op := range []string{"issue.update", "comment.create"} {
+		if err := store.Append(Entry{ID: NewID(), Run: "run-1", Time: now, Op: op, Before: json.RawMessage(`{"title":"Old"}`)}); err != nil {
+			t.Fatalf("Append() error: %v", err)
+		}
+	}
+
+	entries, err = store.List()
+	if err != nil {
+		t.Fatalf("List() error: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Op != "issue.update" || entries[1].Op != "comment.create" {
+		t.Fatalf("unexpected entries %+v", entries)
+	}
+	if string(entries[0].Before) != `{"title":"Old"}` || !entries[0].Time.Equal(now) {
+		t.Fatalf("unexpected entry %+v", entries[0])
+	}
+	if len(entries[0].ID) != 8 || entries[0].ID == entries[1].ID {
+		t.Fatalf("expected distinct 8-character IDs, got %q and %q", entries[0].ID, entries[1].ID)
+	}
+}
+
+func TestDefaultPathUsesXDGDataHome(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", "/tmp/data")
+	path, err := DefaultPath()
+	if err != nil {
+		t.Fatalf("DefaultPath() error: %v", err)
+	}
+	if path != filepath.Join("/tmp/data", "linear", journalFileName) {
+		t.Fatalf("unexpected path %s", path)
+	}
+}
@@ -0,0 +1,103 @@
+package linear
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+const attachmentFields = `id title subtitle url sourceType metadata createdAt`
+
+type attachmentNode struct {
+	ID         string         `json:"id"`
+	Title      string         `json:"title"`
+	Subtitle   string         `json:"subtitle"`
+	URL        string         `json:"url"`
+	SourceType string         `json:"sourceType"`
+	Metadata   map[string]any `json:"metadata"`
+	CreatedAt  string         `json:"createdAt"`
+}
+
+func (n attachmentNode) attachment() Attachment {
+	attachment := Attachment{
+		ID:        n.ID,
+		Title:     n.Title,
+		Subtitle:  n.Subtitle,
+		URL:       n.URL,
+		Source:    n.SourceType,
+		CreatedAt: n.CreatedAt,
+	}
+	if len(n.Metadata) > 0 {
+		attachment.Metadata = n.Metadata
+	}
+	return attachment
+}
+
+type AttachmentCreateInput struct {
+	IssueID  string
+	Title    string
+	Subtitle string
+	URL      string
+}
+
+func (in AttachmentCreateInput) Validate() error {
+	if in.IssueID == "" {
+		return errors.New("attachment issue is required")
+	}
+	if strings.TrimSpace(in.URL) == "" {
+		return errors.New("attachment url is required")
+	}
+	if strings.TrimSpace(in.Title) == "" {
+		return errors.New("attachment title is required")
+	}
+	return nil
+}
+
+func (in AttachmentCreateInput) Variables() map[string]any {
+	vars := map[string]any{
+		"issueId": in.IssueID,
+		"title":   in.Title,
+		"url":     in.URL,
+	}
+	if in.Subtitle != "" {
+		vars["subtitle"] = in.Subtitle
+	}
+	return vars
+}
+
+// IssueAttachments lists the attachments on an issue (linked PRs, docs,
+// uploads), as opposed to IssueUploads which also scrapes markdown links.
+func (c *Client) IssueAttachments(ctx context.Context, issueID string, limit int) ([]Attachment, error) {
+	query := `query($id: String!, $first: Int) {
+  issue(id: $id) {
+    attachments(first: $first) {
+      nodes { ` + attachmentFields + ` }
+    }
+  }
+}`
+
+	var resp struct {
+		Issue *struct {
+			Attachments struct {
+				Nodes []attachmentNode `json:"nodes"`
+			} `json:"attachments"`
+		} `json:"issue"`
+	}
+
+	vars := map[string]any{"id": issueID}
+	if limit > 0 {
+		vars["first"] = limit
+	}
+	if err := c.do(ctx, query, vars, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Issue == nil {
+		return nil, ErrNotFound
+	}
+
+	attachments := make([]Attachment, 0, len(resp.Issue.Attachments.Nodes))
+	for _, node := range resp.Issue.Attachments.Nodes {
+		attachments = append(attachments, node.attachment())
+	}
+	return attachments, nil
+}
@@ -194,3 +194,71 @@ func TestIssueUploadsFiltersNonUploadAttachments(t *testing.T) {
 		t.Fatalf("expected upload attachment, got %s", attachments[0].URL)
 	}
 }
+
+func TestIssueAttachmentsDecodesSourceAndMetadata(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req gqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !strings.Contains(req.Query, "sourceType metadata") || req.Variables["first"] != float64(10) {
+			t.Fatalf("unexpected request %q %v", req.Query, req.Variables)
+		}
+		_, _ = w.Write([]byte(`{"data":{"issue":{"attachments":{"nodes":[
+			{"id":"att-1","title":"PR #42","subtitle":"Open","url":"https://github.com/org/repo/pull/42","sourceType":"github","metadata":{"status":"open"},"createdAt":"2024-05-01T00:00:00Z"},
+			{"id":"att-2","title":"Dashboard","url":"https://grafana.example.com/d/1","metadata":{}}
+		]}}}}`))
+	}))
+	defer srv.Close()
+
+	client := &Client{apiURL: srv.URL, http: srv.Client()}
+	attachments, err := client.IssueAttachments(context.Background(), "issue-1", 10)
+	if err != nil {
+		t.Fatalf("IssueAttachments() error: %v", err)
+	}
+	if len(attachments) != 2 {
+		t.Fatalf("expected 2 attachments, got %d", len(attachments))
+	}
+	if attachments[0].Source != "github" || attachments[0].Subtitle != "Open" || attachments[0].Metadata["status"] != "open" {
+		t.Fatalf("unexpected attachment %+v", attachments[0])
+	}
+	if attachments[1].Metadata != nil {
+		t.Fatalf("expected empty metadata to be dropped, got %v", attachments[1].Metadata)
+	}
+}
+
+func TestAttachmentCreateSendsInput(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req gqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		input := req.Variables["input"].(map[string]any)
+		if input["issueId"] != "issue-1" || input["url"] != "https://github.com/org/repo/pull/42" || input["title"] != "PR #42" {
+			t.Fatalf("unexpected input %v", input)
+		}
+		if _, ok := input["subtitle"]; ok {
+			t.Fatalf("expected empty subtitle to be omitted, got %v", input)
+		}
+		_, _ = w.Write([]byte(`{"data":{"attachmentCreate":{"attachment":{"id":"att-1","title":"PR #42","url":"https://github.com/org/repo/pull/42","sourceType":"github"}}}}`))
+	}))
+	defer srv.Close()
+
+	client := &Client{apiURL: srv.URL, http: srv.Client()}
+	attachment, err := client.AttachmentCreate(context.Background(), AttachmentCreateInput{
+		IssueID: "issue-1",
+		Title:   "PR #42",
+		URL:     "https://github.com/org/repo/pull/42",
+	})
+	if err != nil {
+		t.Fatalf("AttachmentCreate() error: %v", err)
+	}
+	if attachment.ID != "att-1" || attachment.Source != "github" {
+		t.Fatalf("unexpected attachment %+v", attachment)
+	}
+	if _, err := client.AttachmentCreate(context.Background(), AttachmentCreateInput{IssueID: "issue-1", Title: "x"}); err == nil {
+		t.Fatal("expected a missing url to be rejected")
+	}
+}
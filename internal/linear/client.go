@@ -21,21 +21,48 @@ var (
 type API interface {
 	Me(ctx context.Context) (User, error)
 	Teams(ctx context.Context) ([]Team, error)
+	Users(ctx context.Context) ([]User, error)
+	IssueLabels(ctx context.Context, teamID string) ([]Label, error)
+	Projects(ctx context.Context) ([]Project, error)
+	IssueTemplates(ctx context.Context) ([]IssueTemplate, error)
 	ResolveTeamID(ctx context.Context, keyOrID string) (string, error)
 	ResolveUserID(ctx context.Context, value string) (string, error)
 	ResolveStateID(ctx context.Context, teamID, value string) (string, error)
-	ResolveLabelIDs(ctx context.Context, labels []string) ([]string, error)
+	ResolveLabelIDs(ctx context.Context, teamID string, labels []string) ([]string, error)
 	ResolveProjectID(ctx context.Context, value string) (string, error)
 	ResolveCycleID(ctx context.Context, teamID, value string) (string, error)
 	ResolveIssueID(ctx context.Context, value string) (string, error)
 	Issue(ctx context.Context, value string) (IssueDetail, error)
+	IssueChildren(ctx context.Context, issueID string, limit int) ([]IssueSummary, error)
 	IssueComments(ctx context.Context, issueID string, limit int) ([]Comment, error)
+	Comments(ctx context.Context, issueID string, limit int, after string) (CommentPage, error)
+	Comment(ctx context.Context, commentID string) (Comment, error)
 	IssueUploads(ctx context.Context, issueID string, limit int) ([]Attachment, error)
+	IssueAttachments(ctx context.Context, issueID string, limit int) ([]Attachment, error)
 	IssueRelations(ctx context.Context, issueID string, limit int) (IssueRelationSet, error)
+	IssueHistory(ctx context.Context, issueID string, limit int, after string) (IssueHistoryPage, error)
 	Issues(ctx context.Context, filter IssueFilter, limit int, after string) (IssuePage, error)
-	IssueCreate(ctx context.Context, input map[string]any) (IssueSummary, error)
-	IssueUpdate(ctx context.Context, input map[string]any) (IssueSummary, error)
+	CustomViews(ctx context.Context) ([]CustomView, error)
+	ResolveCustomViewID(ctx context.Context, value string) (string, error)
+	CustomViewIssues(ctx context.Context, viewID string, filter IssueFilter, limit int, after string) (IssuePage, error)
+	IssueCreate(ctx context.Context, input IssueCreateInput) (IssueSummary, error)
+	IssueUpdate(ctx context.Context, issueID string, input IssueUpdateInput) (IssueSummary, error)
+	IssueArchive(ctx context.Context, issueID string) error
+	IssueUnarchive(ctx context.Context, issueID string) error
+	IssueDelete(ctx context.Context, issueID string, permanent bool) error
+	IssueSubscribe(ctx context.Context, issueID, userID string) error
+	IssueUnsubscribe(ctx context.Context, issueID, userID string) error
 	IssueComment(ctx context.Context, issueID, body string) (string, error)
+	CommentReply(ctx context.Context, issueID, parentID, body string) (string, error)
+	CommentUpdate(ctx context.Context, commentID, body string) error
+	CommentResolve(ctx context.Context, commentID string) error
+	CommentUnresolve(ctx context.Context, commentID string) error
+	CommentDelete(ctx context.Context, commentID string) error
+	ReactionCreate(ctx context.Context, issueID, commentID, emoji string) (Reaction, error)
+	ReactionDelete(ctx context.Context, reactionID string) error
+	AttachmentCreate(ctx context.Context, input AttachmentCreateInput) (Attachment, error)
+	AttachmentDelete(ctx context.Context, attachmentID string) error
+	UploadFile(ctx context.Context, filename, contentType string, size int64, body io.Reader) (string, error)
 	IssueRelationCreate(ctx context.Context, issueID, relatedIssueID, relationType string) (IssueRelation, error)
 	IssueRelationDelete(ctx context.Context, relationID string) error
 	Cycles(ctx context.Context, teamID string, current bool, limit int, after string) (CyclePage, error)
@@ -47,6 +74,10 @@ type Client struct {
 	apiURL string
 	token  string
 	http   *http.Client
+	// upload sends file bytes to signed upload URLs. It has no timeout of its
+	// own, since a large file can take longer than an API call; the request
+	// context still bounds it.
+	upload *http.Client
 }
 
 type gqlRequest struct {
@@ -94,6 +125,7 @@ func NewClient(token string, timeout time.Duration) API {
 		http: &http.Client{
 			Timeout: timeout,
 		},
+		upload: &http.Client{},
 	}
 }
 
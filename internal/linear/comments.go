@@ -0,0 +1,117 @@
+package linear
+
+import "context"
+
+const commentFields = `id body bodyData url createdAt editedAt resolvedAt
+        parent { id }
+        user { name email }
+        resolvingUser { name }
+        issue { id identifier }
+        reactions { ` + reactionFields + ` }`
+
+type commentNode struct {
+	ID            string         `json:"id"`
+	Body          string         `json:"body"`
+	BodyData      string         `json:"bodyData"`
+	URL           string         `json:"url"`
+	CreatedAt     string         `json:"createdAt"`
+	EditedAt      string         `json:"editedAt"`
+	ResolvedAt    string         `json:"resolvedAt"`
+	Parent        *namedNode     `json:"parent"`
+	User          *User          `json:"user"`
+	ResolvingUser *namedNode     `json:"resolvingUser"`
+	Issue         *issueRefNode  `json:"issue"`
+	Reactions     []reactionNode `json:"reactions"`
+}
+
+func (n commentNode) comment() Comment {
+	comment := Comment{
+		ID:         n.ID,
+		Body:       n.Body,
+		BodyData:   n.BodyData,
+		URL:        n.URL,
+		CreatedAt:  n.CreatedAt,
+		EditedAt:   n.EditedAt,
+		ResolvedAt: n.ResolvedAt,
+		ParentID:   n.Parent.id(),
+		ResolvedBy: n.ResolvingUser.name(),
+		Issue:      n.Issue.identifier(),
+		Reactions:  reactions(n.Reactions),
+	}
+	if n.Issue != nil {
+		comment.IssueID = n.Issue.ID
+	}
+	if n.User != nil {
+		comment.UserName = n.User.Name
+		comment.UserEmail = n.User.Email
+	}
+	return comment
+}
+
+func (c *Client) Comments(ctx context.Context, issueID string, limit int, after string) (CommentPage, error) {
+	query := `query($id: String!, $first: Int, $after: String) {
+  issue(id: $id) {
+    comments(first: $first, after: $after) {
+      nodes {
+        ` + commentFields + `
+      }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+	vars := map[string]any{"id": issueID}
+	if limit > 0 {
+		vars["first"] = limit
+	}
+	if after != "" {
+		vars["after"] = after
+	}
+
+	var resp struct {
+		Issue *struct {
+			Comments struct {
+				Nodes    []commentNode `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"comments"`
+		} `json:"issue"`
+	}
+	if err := c.do(ctx, query, vars, &resp); err != nil {
+		return CommentPage{}, err
+	}
+	if resp.Issue == nil {
+		return CommentPage{}, ErrNotFound
+	}
+
+	page := CommentPage{
+		Nodes: make([]Comment, 0, len(resp.Issue.Comments.Nodes)),
+		PageInfo: PageInfo{
+			HasNextPage: resp.Issue.Comments.PageInfo.HasNextPage,
+			EndCursor:   resp.Issue.Comments.PageInfo.EndCursor,
+		},
+	}
+	for _, node := range resp.Issue.Comments.Nodes {
+		page.Nodes = append(page.Nodes, node.comment())
+	}
+	return page, nil
+}
+
+func (c *Client) Comment(ctx context.Context, commentID string) (Comment, error) {
+	query := `query($id: String!) {
+  comment(id: $id) {
+    ` + commentFields + `
+  }
+}`
+	var resp struct {
+		Comment *commentNode `json:"comment"`
+	}
+	if err := c.do(ctx, query, map[string]any{"id": commentID}, &resp); err != nil {
+		return Comment{}, err
+	}
+	if resp.Comment == nil {
+		return Comment{}, ErrNotFound
+	}
+	return resp.Comment.comment(), nil
+}
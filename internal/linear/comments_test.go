@@ -0,0 +1,87 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCommentsMapsThreadFields(t *testing.T) {
+	var got gqlRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		resp := map[string]any{
+			"data": map[string]any{
+				"issue": map[string]any{
+					"comments": map[string]any{
+						"nodes": []map[string]any{
+							{
+								"id":            "c2",
+								"body":          "Agreed",
+								"createdAt":     "2026-01-02T10:00:00Z",
+								"resolvedAt":    "2026-01-03T10:00:00Z",
+								"parent":        map[string]any{"id": "c1"},
+								"user":          map[string]any{"name": "Ada", "email": "ada@example.com"},
+								"resolvingUser": map[string]any{"name": "Grace"},
+								"issue":         map[string]any{"id": "issue-1", "identifier": "ENG-1"},
+							},
+						},
+						"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "cursor-2"},
+					},
+				},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	client := &Client{apiURL: srv.URL, http: srv.Client()}
+	page, err := client.Comments(context.Background(), "ENG-1", 10, "cursor-1")
+	if err != nil {
+		t.Fatalf("Comments() error: %v", err)
+	}
+	if got.Variables["after"] != "cursor-1" || got.Variables["first"] != float64(10) {
+		t.Fatalf("unexpected variables %v", got.Variables)
+	}
+	if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor != "cursor-2" || len(page.Nodes) != 1 {
+		t.Fatalf("unexpected page %+v", page)
+	}
+	comment := page.Nodes[0]
+	if comment.ParentID != "c1" || comment.ResolvedBy != "Grace" || comment.Issue != "ENG-1" || comment.IssueID != "issue-1" || comment.UserName != "Ada" {
+		t.Fatalf("unexpected comment %+v", comment)
+	}
+}
+
+func TestDryRunRecordsCommentMutations(t *testing.T) {
+	log := &MutationLog{}
+	api := DryRun(readOnlyAPI{}, log)
+	ctx := context.Background()
+
+	if id, err := api.CommentReply(ctx, "issue-1", "c1", "Thanks"); err != nil || id != DryRunID {
+		t.Fatalf("CommentReply() = %q, %v", id, err)
+	}
+	if err := api.CommentUpdate(ctx, "c1", "Edited"); err != nil {
+		t.Fatalf("CommentUpdate() error: %v", err)
+	}
+	if err := api.CommentResolve(ctx, "c1"); err != nil {
+		t.Fatalf("CommentResolve() error: %v", err)
+	}
+
+	mutations := log.Mutations()
+	if len(mutations) != 3 {
+		t.Fatalf("expected 3 mutations, got %d", len(mutations))
+	}
+	input := mutations[0].Variables["input"].(map[string]any)
+	if input["parentId"] != "c1" || input["issueId"] != "issue-1" {
+		t.Fatalf("unexpected reply variables %v", mutations[0].Variables)
+	}
+	if !strings.Contains(mutations[1].Query, "commentUpdate(id: $id, input: $input)") {
+		t.Fatalf("unexpected update query %q", mutations[1].Query)
+	}
+	if !strings.Contains(mutations[2].Query, "commentResolve(id: $id)") {
+		t.Fatalf("unexpected resolve query %q", mutations[2].Query)
+	}
+}
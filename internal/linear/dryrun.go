@@ -0,0 +1,175 @@
+package linear
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"sync"
+)
+
+const DryRunID = "dry-run"
+
+type MutationLog struct {
+	mu        sync.Mutex
+	mutations []Mutation
+}
+
+func (l *MutationLog) record(m Mutation) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.mutations = append(l.mutations, m)
+}
+
+func (l *MutationLog) Mutations() []Mutation {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]Mutation(nil), l.mutations...)
+}
+
+type dryRunAPI struct {
+	API
+	log *MutationLog
+}
+
+// DryRun wraps api so reads go through and mutations are only recorded in log.
+func DryRun(api API, log *MutationLog) API {
+	return &dryRunAPI{API: api, log: log}
+}
+
+// ResolveIssueID, Issue and IssueRelations answer for the placeholder ID
+// themselves, so later steps can refer to an issue that was only pretend-created.
+func (d *dryRunAPI) ResolveIssueID(ctx context.Context, value string) (string, error) {
+	if value == DryRunID {
+		return DryRunID, nil
+	}
+	return d.API.ResolveIssueID(ctx, value)
+}
+
+func (d *dryRunAPI) Issue(ctx context.Context, value string) (IssueDetail, error) {
+	if value == DryRunID {
+		return IssueDetail{ID: DryRunID, Identifier: DryRunID}, nil
+	}
+	return d.API.Issue(ctx, value)
+}
+
+func (d *dryRunAPI) IssueRelations(ctx context.Context, issueID string, limit int) (IssueRelationSet, error) {
+	if issueID == DryRunID {
+		return IssueRelationSet{}, nil
+	}
+	return d.API.IssueRelations(ctx, issueID, limit)
+}
+
+func (d *dryRunAPI) IssueCreate(_ context.Context, input IssueCreateInput) (IssueSummary, error) {
+	if err := input.Validate(); err != nil {
+		return IssueSummary{}, err
+	}
+	d.log.record(issueCreateMutation(input))
+	return IssueSummary{ID: DryRunID, Identifier: DryRunID, Title: input.Title}, nil
+}
+
+func (d *dryRunAPI) IssueUpdate(_ context.Context, issueID string, input IssueUpdateInput) (IssueSummary, error) {
+	if err := input.Validate(); err != nil {
+		return IssueSummary{}, err
+	}
+	d.log.record(issueUpdateMutation(issueID, input))
+	return IssueSummary{ID: issueID}, nil
+}
+
+func (d *dryRunAPI) IssueArchive(_ context.Context, issueID string) error {
+	d.log.record(issueArchiveMutation(issueID))
+	return nil
+}
+
+func (d *dryRunAPI) IssueUnarchive(_ context.Context, issueID string) error {
+	d.log.record(issueUnarchiveMutation(issueID))
+	return nil
+}
+
+func (d *dryRunAPI) IssueDelete(_ context.Context, issueID string, permanent bool) error {
+	d.log.record(issueDeleteMutation(issueID, permanent))
+	return nil
+}
+
+func (d *dryRunAPI) IssueSubscribe(_ context.Context, issueID, userID string) error {
+	d.log.record(issueSubscribeMutation(issueID, userID))
+	return nil
+}
+
+func (d *dryRunAPI) IssueUnsubscribe(_ context.Context, issueID, userID string) error {
+	d.log.record(issueUnsubscribeMutation(issueID, userID))
+	return nil
+}
+
+func (d *dryRunAPI) IssueComment(_ context.Context, issueID, body string) (string, error) {
+	d.log.record(issueCommentMutation(issueID, body))
+	return DryRunID, nil
+}
+
+func (d *dryRunAPI) CommentReply(_ context.Context, issueID, parentID, body string) (string, error) {
+	d.log.record(commentReplyMutation(issueID, parentID, body))
+	return DryRunID, nil
+}
+
+func (d *dryRunAPI) CommentUpdate(_ context.Context, commentID, body string) error {
+	d.log.record(commentUpdateMutation(commentID, body))
+	return nil
+}
+
+func (d *dryRunAPI) CommentResolve(_ context.Context, commentID string) error {
+	d.log.record(commentResolveMutation(commentID))
+	return nil
+}
+
+func (d *dryRunAPI) CommentUnresolve(_ context.Context, commentID string) error {
+	d.log.record(commentUnresolveMutation(commentID))
+	return nil
+}
+
+func (d *dryRunAPI) CommentDelete(_ context.Context, commentID string) error {
+	d.log.record(commentDeleteMutation(commentID))
+	return nil
+}
+
+func (d *dryRunAPI) ReactionCreate(_ context.Context, issueID, commentID, emoji string) (Reaction, error) {
+	if err := validateReaction(issueID, commentID, emoji); err != nil {
+		return Reaction{}, err
+	}
+	d.log.record(reactionCreateMutation(issueID, commentID, emoji))
+	return Reaction{ID: DryRunID, Emoji: emoji}, nil
+}
+
+func (d *dryRunAPI) ReactionDelete(_ context.Context, reactionID string) error {
+	d.log.record(reactionDeleteMutation(reactionID))
+	return nil
+}
+
+func (d *dryRunAPI) AttachmentCreate(_ context.Context, input AttachmentCreateInput) (Attachment, error) {
+	if err := input.Validate(); err != nil {
+		return Attachment{}, err
+	}
+	d.log.record(attachmentCreateMutation(input))
+	return Attachment{ID: DryRunID, Title: input.Title, Subtitle: input.Subtitle, URL: input.URL}, nil
+}
+
+func (d *dryRunAPI) AttachmentDelete(_ context.Context, attachmentID string) error {
+	d.log.record(attachmentDeleteMutation(attachmentID))
+	return nil
+}
+
+func (d *dryRunAPI) UploadFile(_ context.Context, filename, contentType string, size int64, _ io.Reader) (string, error) {
+	if err := validateUpload(filename, contentType, size); err != nil {
+		return "", err
+	}
+	d.log.record(fileUploadMutation(filename, contentType, size))
+	return "https://uploads.linear.app/" + DryRunID + "/" + url.PathEscape(filename), nil
+}
+
+func (d *dryRunAPI) IssueRelationCreate(_ context.Context, issueID, relatedIssueID, relationType string) (IssueRelation, error) {
+	d.log.record(issueRelationCreateMutation(issueID, relatedIssueID, relationType))
+	return IssueRelation{ID: DryRunID, IssueID: issueID, RelatedIssueID: relatedIssueID, Type: relationType}, nil
+}
+
+func (d *dryRunAPI) IssueRelationDelete(_ context.Context, relationID string) error {
+	d.log.record(issueRelationDeleteMutation(relationID))
+	return nil
+}
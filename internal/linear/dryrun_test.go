@@ -0,0 +1,61 @@
+package linear
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+type readOnlyAPI struct {
+	API
+}
+
+func TestDryRunRecordsMutations(t *testing.T) {
+	log := &MutationLog{}
+	api := DryRun(readOnlyAPI{}, log)
+	ctx := context.Background()
+
+	issue, err := api.IssueCreate(ctx, IssueCreateInput{TeamID: "team-1", Title: "New"})
+	if err != nil {
+		t.Fatalf("IssueCreate() error: %v", err)
+	}
+	if issue.ID != DryRunID {
+		t.Fatalf("expected placeholder ID, got %q", issue.ID)
+	}
+	if _, err := api.IssueUpdate(ctx, "issue-1", IssueUpdateInput{StateID: Null[string]()}); err == nil {
+		t.Fatal("expected invalid update input to be rejected")
+	}
+	if err := api.IssueDelete(ctx, "issue-1", true); err != nil {
+		t.Fatalf("IssueDelete() error: %v", err)
+	}
+
+	mutations := log.Mutations()
+	if len(mutations) != 2 {
+		t.Fatalf("expected 2 mutations, got %d", len(mutations))
+	}
+	if !strings.Contains(mutations[0].Query, "issueCreate(input: $input)") {
+		t.Fatalf("unexpected query %q", mutations[0].Query)
+	}
+	input := mutations[0].Variables["input"].(map[string]any)
+	if input["teamId"] != "team-1" || input["title"] != "New" {
+		t.Fatalf("unexpected variables %v", mutations[0].Variables)
+	}
+	if mutations[1].Variables["permanentlyDelete"] != true {
+		t.Fatalf("unexpected delete variables %v", mutations[1].Variables)
+	}
+}
+
+func TestDryRunAnswersForPlaceholderIssue(t *testing.T) {
+	api := DryRun(readOnlyAPI{}, &MutationLog{})
+	ctx := context.Background()
+
+	if id, err := api.ResolveIssueID(ctx, DryRunID); err != nil || id != DryRunID {
+		t.Fatalf("ResolveIssueID() = %q, %v", id, err)
+	}
+	if issue, err := api.Issue(ctx, DryRunID); err != nil || issue.ID != DryRunID {
+		t.Fatalf("Issue() = %+v, %v", issue, err)
+	}
+	if _, err := api.IssueRelations(ctx, DryRunID, 50); err != nil {
+		t.Fatalf("IssueRelations() error: %v", err)
+	}
+}
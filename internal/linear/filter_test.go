@@ -3,7 +3,6 @@ package linear
 import "testing"
 
 func TestBuildIssueFilter(t *testing.T) {
-	priority := 2
 	filter := IssueFilter{
 		TeamID:     "team",
 		AssigneeID: "assignee",
@@ -12,7 +11,8 @@ func TestBuildIssueFilter(t *testing.T) {
 		ProjectID:  "project",
 		CycleID:    "cycle",
 		Search:     "bug",
-		Priority:   &priority,
+		Priorities: []Priority{PriorityHigh},
+		ParentID:   "parent",
 	}
 
 	out := buildIssueFilter(filter)
@@ -22,4 +22,34 @@ func TestBuildIssueFilter(t *testing.T) {
 	if out["team"] == nil || out["assignee"] == nil || out["state"] == nil {
 		t.Fatalf("missing expected keys")
 	}
+	if out["priority"] == nil {
+		t.Fatalf("missing priority key")
+	}
+	if out["parent"] == nil {
+		t.Fatalf("missing parent key")
+	}
+}
+
+func TestBuildIssueFilterPriorityRange(t *testing.T) {
+	out := buildIssueFilter(IssueFilter{Priorities: []Priority{PriorityUrgent, PriorityHigh}})
+	priority, ok := out["priority"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected priority filter, got %v", out["priority"])
+	}
+	values, ok := priority["in"].([]int)
+	if !ok || len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Fatalf("expected in [1 2], got %v", priority)
+	}
+}
+
+func TestBuildIssueFilterSubscriber(t *testing.T) {
+	out := buildIssueFilter(IssueFilter{SubscriberID: "user-1"})
+	subscribers, ok := out["subscribers"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected subscribers filter, got %v", out)
+	}
+	some, ok := subscribers["some"].(map[string]any)
+	if !ok || some["id"].(map[string]any)["eq"] != "user-1" {
+		t.Fatalf("unexpected subscribers filter %v", subscribers)
+	}
 }
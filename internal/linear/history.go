@@ -0,0 +1,198 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const issueHistoryFields = `id createdAt
+        actor { name }
+        fromTitle toTitle
+        fromState { name } toState { name }
+        fromAssignee { name } toAssignee { name }
+        fromPriority toPriority
+        fromEstimate toEstimate
+        fromCycle { name number } toCycle { name number }
+        fromProject { name } toProject { name }
+        fromParent { identifier } toParent { identifier }
+        fromDueDate toDueDate
+        addedLabels { name } removedLabels { name }
+        updatedDescription archived trashed`
+
+type issueHistoryNode struct {
+	ID            string            `json:"id"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	Actor         *namedNode        `json:"actor"`
+	FromTitle     *string           `json:"fromTitle"`
+	ToTitle       *string           `json:"toTitle"`
+	FromState     *namedNode        `json:"fromState"`
+	ToState       *namedNode        `json:"toState"`
+	FromAssignee  *namedNode        `json:"fromAssignee"`
+	ToAssignee    *namedNode        `json:"toAssignee"`
+	FromPriority  *float64          `json:"fromPriority"`
+	ToPriority    *float64          `json:"toPriority"`
+	FromEstimate  *float64          `json:"fromEstimate"`
+	ToEstimate    *float64          `json:"toEstimate"`
+	FromCycle     *historyCycleNode `json:"fromCycle"`
+	ToCycle       *historyCycleNode `json:"toCycle"`
+	FromProject   *namedNode        `json:"fromProject"`
+	ToProject     *namedNode        `json:"toProject"`
+	FromParent    *issueRefNode     `json:"fromParent"`
+	ToParent      *issueRefNode     `json:"toParent"`
+	FromDueDate   *string           `json:"fromDueDate"`
+	ToDueDate     *string           `json:"toDueDate"`
+	AddedLabels   []namedNode       `json:"addedLabels"`
+	RemovedLabels []namedNode       `json:"removedLabels"`
+
+	UpdatedDescription bool `json:"updatedDescription"`
+	Archived           bool `json:"archived"`
+	Trashed            bool `json:"trashed"`
+}
+
+type historyCycleNode struct {
+	Name   string `json:"name"`
+	Number int    `json:"number"`
+}
+
+func (n *historyCycleNode) label() string {
+	switch {
+	case n == nil:
+		return ""
+	case n.Name != "":
+		return n.Name
+	default:
+		return fmt.Sprintf("Cycle %d", n.Number)
+	}
+}
+
+func (c *Client) IssueHistory(ctx context.Context, issueID string, limit int, after string) (IssueHistoryPage, error) {
+	query := `query($id: String!, $first: Int, $after: String) {
+  issue(id: $id) {
+    history(first: $first, after: $after) {
+      nodes {
+        ` + issueHistoryFields + `
+      }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+	vars := map[string]any{"id": issueID}
+	if limit > 0 {
+		vars["first"] = limit
+	}
+	if after != "" {
+		vars["after"] = after
+	}
+
+	var resp struct {
+		Issue *struct {
+			History struct {
+				Nodes    []issueHistoryNode `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"history"`
+		} `json:"issue"`
+	}
+	if err := c.do(ctx, query, vars, &resp); err != nil {
+		return IssueHistoryPage{}, err
+	}
+	if resp.Issue == nil {
+		return IssueHistoryPage{}, ErrNotFound
+	}
+
+	page := IssueHistoryPage{
+		Nodes: make([]IssueHistoryEntry, 0, len(resp.Issue.History.Nodes)),
+		PageInfo: PageInfo{
+			HasNextPage: resp.Issue.History.PageInfo.HasNextPage,
+			EndCursor:   resp.Issue.History.PageInfo.EndCursor,
+		},
+	}
+	for _, node := range resp.Issue.History.Nodes {
+		page.Nodes = append(page.Nodes, node.entry())
+	}
+	return page, nil
+}
+
+func (node issueHistoryNode) entry() IssueHistoryEntry {
+	entry := IssueHistoryEntry{
+		ID:        node.ID,
+		CreatedAt: node.CreatedAt,
+		Actor:     node.Actor.name(),
+		Changes:   []HistoryChange{},
+	}
+	add := func(field, from, to string) {
+		if from != to {
+			entry.Changes = append(entry.Changes, HistoryChange{Field: field, From: from, To: to})
+		}
+	}
+
+	if node.FromTitle != nil || node.ToTitle != nil {
+		add("title", stringValue(node.FromTitle), stringValue(node.ToTitle))
+	}
+	add("state", node.FromState.name(), node.ToState.name())
+	add("assignee", node.FromAssignee.name(), node.ToAssignee.name())
+	if node.FromPriority != nil || node.ToPriority != nil {
+		add("priority", priorityValue(node.FromPriority), priorityValue(node.ToPriority))
+	}
+	add("estimate", floatValue(node.FromEstimate), floatValue(node.ToEstimate))
+	add("cycle", node.FromCycle.label(), node.ToCycle.label())
+	add("project", node.FromProject.name(), node.ToProject.name())
+	add("parent", node.FromParent.identifier(), node.ToParent.identifier())
+	add("due_date", stringValue(node.FromDueDate), stringValue(node.ToDueDate))
+	if len(node.AddedLabels) > 0 || len(node.RemovedLabels) > 0 {
+		entry.Changes = append(entry.Changes, HistoryChange{
+			Field:   "labels",
+			Added:   nodeNames(node.AddedLabels),
+			Removed: nodeNames(node.RemovedLabels),
+		})
+	}
+	if node.UpdatedDescription {
+		entry.Changes = append(entry.Changes, HistoryChange{Field: "description", To: "updated"})
+	}
+	if node.Archived {
+		entry.Changes = append(entry.Changes, HistoryChange{Field: "archived", To: "true"})
+	}
+	if node.Trashed {
+		entry.Changes = append(entry.Changes, HistoryChange{Field: "trashed", To: "true"})
+	}
+	return entry
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func floatValue(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%g", *value)
+}
+
+func priorityValue(value *float64) string {
+	if value == nil {
+		return PriorityNone.String()
+	}
+	return Priority(int(*value)).String()
+}
+
+func nodeNames(nodes []namedNode) []string {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+	return names
+}
+
+func (n *issueRefNode) identifier() string {
+	if n == nil {
+		return ""
+	}
+	return n.Identifier
+}
@@ -0,0 +1,74 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIssueHistoryMapsChanges(t *testing.T) {
+	var got gqlRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		resp := map[string]any{
+			"data": map[string]any{
+				"issue": map[string]any{
+					"history": map[string]any{
+						"nodes": []map[string]any{
+							{
+								"id":            "h1",
+								"createdAt":     "2026-01-02T10:00:00Z",
+								"actor":         map[string]any{"name": "Ada"},
+								"fromState":     map[string]any{"name": "Todo"},
+								"toState":       map[string]any{"name": "In Progress"},
+								"fromPriority":  0,
+								"toPriority":    2,
+								"toCycle":       map[string]any{"name": "", "number": 7},
+								"addedLabels":   []map[string]any{{"name": "bug"}},
+								"removedLabels": []map[string]any{},
+								"fromEstimate":  2,
+								"toEstimate":    2,
+							},
+						},
+						"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c1"},
+					},
+				},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	client := &Client{apiURL: srv.URL, http: srv.Client()}
+	page, err := client.IssueHistory(context.Background(), "ENG-1", 25, "c0")
+	if err != nil {
+		t.Fatalf("IssueHistory() error: %v", err)
+	}
+	if got.Variables["first"] != float64(25) || got.Variables["after"] != "c0" {
+		t.Fatalf("unexpected variables %v", got.Variables)
+	}
+	if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor != "c1" || len(page.Nodes) != 1 {
+		t.Fatalf("unexpected page %+v", page)
+	}
+	entry := page.Nodes[0]
+	if entry.Actor != "Ada" || entry.CreatedAt.IsZero() {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+	expected := []HistoryChange{
+		{Field: "state", From: "Todo", To: "In Progress"},
+		{Field: "priority", From: "None", To: "High"},
+		{Field: "cycle", To: "Cycle 7"},
+		{Field: "labels", Added: []string{"bug"}, Removed: []string{}},
+	}
+	if len(entry.Changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %+v", len(expected), entry.Changes)
+	}
+	for i, want := range expected {
+		change := entry.Changes[i]
+		if change.Field != want.Field || change.From != want.From || change.To != want.To || len(change.Added) != len(want.Added) {
+			t.Fatalf("change %d: expected %+v, got %+v", i, want, change)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package linear
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Nullable is an optional mutation field. The zero value leaves the field
+// unchanged; Set sends a value and Null sends an explicit null to clear it.
+type Nullable[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+func Set[T any](value T) Nullable[T] {
+	return Nullable[T]{value: value, set: true}
+}
+
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{null: true}
+}
+
+func (n Nullable[T]) IsSet() bool {
+	return n.set || n.null
+}
+
+func (n Nullable[T]) IsNull() bool {
+	return n.null
+}
+
+func (n Nullable[T]) Value() (T, bool) {
+	return n.value, n.set
+}
+
+func (n Nullable[T]) put(vars map[string]any, key string) {
+	switch {
+	case n.null:
+		vars[key] = nil
+	case n.set:
+		vars[key] = n.value
+	}
+}
+
+type IssueCreateInput struct {
+	TeamID      string
+	Title       string
+	Description string
+	AssigneeID  string
+	StateID     string
+	Priority    *Priority
+	ProjectID   string
+	CycleID     string
+	LabelIDs    []string
+	Estimate    *float64
+	DueDate     *Date
+	ParentID    string
+}
+
+func (in IssueCreateInput) Validate() error {
+	if in.TeamID == "" {
+		return errors.New("issue team is required")
+	}
+	if strings.TrimSpace(in.Title) == "" {
+		return errors.New("issue title is required")
+	}
+	if in.Priority != nil && !in.Priority.Valid() {
+		return fmt.Errorf("invalid priority %d", *in.Priority)
+	}
+	return nil
+}
+
+func (in IssueCreateInput) Variables() map[string]any {
+	vars := map[string]any{
+		"teamId": in.TeamID,
+		"title":  in.Title,
+	}
+	if in.Description != "" {
+		vars["description"] = in.Description
+	}
+	if in.AssigneeID != "" {
+		vars["assigneeId"] = in.AssigneeID
+	}
+	if in.StateID != "" {
+		vars["stateId"] = in.StateID
+	}
+	if in.Priority != nil {
+		vars["priority"] = int(*in.Priority)
+	}
+	if in.ProjectID != "" {
+		vars["projectId"] = in.ProjectID
+	}
+	if in.CycleID != "" {
+		vars["cycleId"] = in.CycleID
+	}
+	if len(in.LabelIDs) > 0 {
+		vars["labelIds"] = in.LabelIDs
+	}
+	if in.Estimate != nil {
+		vars["estimate"] = *in.Estimate
+	}
+	if in.DueDate != nil {
+		vars["dueDate"] = in.DueDate.String()
+	}
+	if in.ParentID != "" {
+		vars["parentId"] = in.ParentID
+	}
+	return vars
+}
+
+type IssueUpdateInput struct {
+	TeamID      Nullable[string]
+	Title       Nullable[string]
+	Description Nullable[string]
+	AssigneeID  Nullable[string]
+	StateID     Nullable[string]
+	Priority    Nullable[Priority]
+	ProjectID   Nullable[string]
+	CycleID     Nullable[string]
+	LabelIDs    Nullable[[]string]
+	Estimate    Nullable[float64]
+	DueDate     Nullable[Date]
+	ParentID    Nullable[string]
+
+	AddedLabelIDs   []string
+	RemovedLabelIDs []string
+}
+
+func (in IssueUpdateInput) Validate() error {
+	if in.TeamID.IsNull() {
+		return errors.New("issue team cannot be cleared")
+	}
+	if in.StateID.IsNull() {
+		return errors.New("issue state cannot be cleared")
+	}
+	if in.Priority.IsNull() {
+		return errors.New("issue priority cannot be cleared; set it to none instead")
+	}
+	if in.Title.IsNull() {
+		return errors.New("issue title cannot be cleared")
+	}
+	if title, ok := in.Title.Value(); ok && strings.TrimSpace(title) == "" {
+		return errors.New("issue title cannot be empty")
+	}
+	if priority, ok := in.Priority.Value(); ok && !priority.Valid() {
+		return fmt.Errorf("invalid priority %d", priority)
+	}
+	if in.LabelIDs.IsSet() && (len(in.AddedLabelIDs) > 0 || len(in.RemovedLabelIDs) > 0) {
+		return errors.New("label ids cannot be replaced and edited in the same update")
+	}
+	return nil
+}
+
+func (in IssueUpdateInput) IsEmpty() bool {
+	return len(in.Variables()) == 0
+}
+
+func (in IssueUpdateInput) Variables() map[string]any {
+	vars := map[string]any{}
+	in.TeamID.put(vars, "teamId")
+	in.Title.put(vars, "title")
+	in.Description.put(vars, "description")
+	in.AssigneeID.put(vars, "assigneeId")
+	in.StateID.put(vars, "stateId")
+	if priority, ok := in.Priority.Value(); ok {
+		vars["priority"] = int(priority)
+	}
+	in.ProjectID.put(vars, "projectId")
+	in.CycleID.put(vars, "cycleId")
+	if in.LabelIDs.IsNull() {
+		vars["labelIds"] = []string{}
+	} else {
+		in.LabelIDs.put(vars, "labelIds")
+	}
+	if len(in.AddedLabelIDs) > 0 {
+		vars["addedLabelIds"] = in.AddedLabelIDs
+	}
+	if len(in.RemovedLabelIDs) > 0 {
+		vars["removedLabelIds"] = in.RemovedLabelIDs
+	}
+	in.Estimate.put(vars, "estimate")
+	if in.DueDate.IsNull() {
+		vars["dueDate"] = nil
+	} else if due, ok := in.DueDate.Value(); ok {
+		vars["dueDate"] = due.String()
+	}
+	in.ParentID.put(vars, "parentId")
+	return vars
+}
@@ -0,0 +1,103 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIssueUpdateInputVariables(t *testing.T) {
+	input := IssueUpdateInput{
+		Title:      Set("New title"),
+		AssigneeID: Null[string](),
+		Priority:   Set(PriorityUrgent),
+		LabelIDs:   Null[[]string](),
+	}
+	vars := input.Variables()
+
+	if vars["title"] != "New title" {
+		t.Fatalf("expected title, got %v", vars["title"])
+	}
+	if value, ok := vars["assigneeId"]; !ok || value != nil {
+		t.Fatalf("expected explicit null assigneeId, got %v (present %t)", value, ok)
+	}
+	if vars["priority"] != 1 {
+		t.Fatalf("expected priority 1, got %v", vars["priority"])
+	}
+	if labels, ok := vars["labelIds"].([]string); !ok || len(labels) != 0 {
+		t.Fatalf("expected empty labelIds, got %v", vars["labelIds"])
+	}
+	if _, ok := vars["stateId"]; ok {
+		t.Fatalf("expected unchanged stateId to be omitted")
+	}
+}
+
+func TestIssueUpdateInputValidate(t *testing.T) {
+	cases := map[string]IssueUpdateInput{
+		"null title":    {Title: Null[string]()},
+		"empty title":   {Title: Set("  ")},
+		"null state":    {StateID: Null[string]()},
+		"null priority": {Priority: Null[Priority]()},
+		"bad priority":  {Priority: Set(Priority(9))},
+	}
+	for name, input := range cases {
+		if err := input.Validate(); err == nil {
+			t.Fatalf("%s: expected validation error", name)
+		}
+	}
+	if err := (IssueUpdateInput{CycleID: Null[string]()}).Validate(); err != nil {
+		t.Fatalf("expected clearing cycle to be valid, got %v", err)
+	}
+}
+
+func TestIssueCreateInputValidate(t *testing.T) {
+	if err := (IssueCreateInput{Title: "x"}).Validate(); err == nil {
+		t.Fatalf("expected error for missing team")
+	}
+	if err := (IssueCreateInput{TeamID: "team"}).Validate(); err == nil {
+		t.Fatalf("expected error for missing title")
+	}
+}
+
+func TestIssueUpdateSendsNulls(t *testing.T) {
+	var sent map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req gqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		sent = req.Variables
+		resp := map[string]any{
+			"data": map[string]any{
+				"issueUpdate": map[string]any{
+					"issue": map[string]any{"id": "issue-1", "identifier": "ENG-1"},
+				},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		apiURL: srv.URL,
+		http:   srv.Client(),
+	}
+
+	_, err := client.IssueUpdate(context.Background(), "issue-1", IssueUpdateInput{CycleID: Null[string]()})
+	if err != nil {
+		t.Fatalf("IssueUpdate() error: %v", err)
+	}
+	if sent["id"] != "issue-1" {
+		t.Fatalf("expected id variable, got %v", sent["id"])
+	}
+	input, ok := sent["input"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected input object, got %v", sent["input"])
+	}
+	if value, ok := input["cycleId"]; !ok || value != nil {
+		t.Fatalf("expected cycleId null, got %v (present %t)", value, ok)
+	}
+}
@@ -0,0 +1,131 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIssueDecodesDetailFields(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := map[string]any{
+			"data": map[string]any{
+				"issue": map[string]any{
+					"id":          "issue-1",
+					"identifier":  "ENG-1",
+					"title":       "Parent",
+					"priority":    2,
+					"estimate":    3,
+					"dueDate":     "2026-02-01",
+					"createdAt":   "2026-01-01T10:00:00Z",
+					"updatedAt":   "2026-01-02T10:00:00Z",
+					"completedAt": "2026-01-03T10:00:00Z",
+					"branchName":  "eng-1-parent",
+					"state":       map[string]any{"name": "Done", "type": "completed"},
+					"creator":     map[string]any{"name": "Ada"},
+					"team":        map[string]any{"id": "team-1", "key": "ENG"},
+					"labels":      map[string]any{"nodes": []map[string]any{{"name": "bug"}}},
+					"subscribers": map[string]any{"nodes": []map[string]any{{"id": "user-1", "name": "Ada", "email": "ada@example.com"}}},
+					"children": map[string]any{
+						"nodes": []map[string]any{
+							{
+								"id":         "issue-2",
+								"identifier": "ENG-2",
+								"title":      "Child",
+								"state":      map[string]any{"name": "Todo", "type": "unstarted"},
+								"team":       map[string]any{"id": "team-1", "key": "ENG"},
+								"parent":     map[string]any{"identifier": "ENG-1"},
+							},
+						},
+					},
+				},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		apiURL: srv.URL,
+		http:   srv.Client(),
+	}
+
+	issue, err := client.Issue(context.Background(), "ENG-1")
+	if err != nil {
+		t.Fatalf("Issue() error: %v", err)
+	}
+	if issue.Priority != PriorityHigh {
+		t.Fatalf("expected high priority, got %v", issue.Priority)
+	}
+	if issue.Estimate == nil || *issue.Estimate != 3 {
+		t.Fatalf("expected estimate 3, got %v", issue.Estimate)
+	}
+	if issue.DueDate == nil || issue.DueDate.String() != "2026-02-01" {
+		t.Fatalf("expected due date, got %v", issue.DueDate)
+	}
+	if !issue.CreatedAt.Equal(time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected created at: %v", issue.CreatedAt)
+	}
+	if issue.CompletedAt == nil || issue.StartedAt != nil {
+		t.Fatalf("unexpected completion times: %v %v", issue.CompletedAt, issue.StartedAt)
+	}
+	if issue.StateType != "completed" || issue.Creator != "Ada" || issue.BranchName != "eng-1-parent" {
+		t.Fatalf("unexpected detail fields: %+v", issue)
+	}
+	if len(issue.Subscribers) != 1 || issue.Subscribers[0].Email != "ada@example.com" {
+		t.Fatalf("unexpected subscribers: %+v", issue.Subscribers)
+	}
+	if len(issue.SubIssues) != 1 || issue.SubIssues[0].Parent != "ENG-1" || issue.SubIssues[0].StateType != "unstarted" {
+		t.Fatalf("unexpected sub-issues: %+v", issue.SubIssues)
+	}
+
+	encoded, err := json.Marshal(issue)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(encoded, &payload); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if payload["due_date"] != "2026-02-01" {
+		t.Fatalf("expected due_date in JSON, got %v", payload["due_date"])
+	}
+}
+
+func TestIssueDeleteSendsPermanentFlag(t *testing.T) {
+	var got gqlRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decode request: %v", err)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"issueDelete": map[string]any{"success": true}},
+		})
+	}))
+	defer srv.Close()
+
+	client := &Client{apiURL: srv.URL, http: srv.Client()}
+	if err := client.IssueDelete(context.Background(), "issue-1", true); err != nil {
+		t.Fatalf("IssueDelete() error: %v", err)
+	}
+	if got.Variables["id"] != "issue-1" || got.Variables["permanentlyDelete"] != true {
+		t.Fatalf("unexpected variables: %v", got.Variables)
+	}
+}
+
+func TestIssueArchiveReportsFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": map[string]any{"issueArchive": map[string]any{"success": false}},
+		})
+	}))
+	defer srv.Close()
+
+	client := &Client{apiURL: srv.URL, http: srv.Client()}
+	if err := client.IssueArchive(context.Background(), "issue-1"); err == nil {
+		t.Fatal("expected error for unsuccessful archive")
+	}
+}
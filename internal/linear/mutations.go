@@ -0,0 +1,433 @@
+package linear
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type Mutation struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+func issueCreateMutation(input IssueCreateInput) Mutation {
+	return Mutation{
+		Query: `mutation($input: IssueCreateInput!) {
+  issueCreate(input: $input) {
+    issue { id identifier title url }
+  }
+}`,
+		Variables: map[string]any{"input": input.Variables()},
+	}
+}
+
+func issueUpdateMutation(issueID string, input IssueUpdateInput) Mutation {
+	return Mutation{
+		Query: `mutation($id: String!, $input: IssueUpdateInput!) {
+  issueUpdate(id: $id, input: $input) {
+    issue { id identifier title url }
+  }
+}`,
+		Variables: map[string]any{"id": issueID, "input": input.Variables()},
+	}
+}
+
+func issueArchiveMutation(issueID string) Mutation {
+	return Mutation{
+		Query: `mutation($id: String!) {
+  issueArchive(id: $id) {
+    success
+  }
+}`,
+		Variables: map[string]any{"id": issueID},
+	}
+}
+
+func issueUnarchiveMutation(issueID string) Mutation {
+	return Mutation{
+		Query: `mutation($id: String!) {
+  issueUnarchive(id: $id) {
+    success
+  }
+}`,
+		Variables: map[string]any{"id": issueID},
+	}
+}
+
+func issueDeleteMutation(issueID string, permanent bool) Mutation {
+	vars := map[string]any{"id": issueID}
+	if permanent {
+		vars["permanentlyDelete"] = true
+	}
+	return Mutation{
+		Query: `mutation($id: String!, $permanentlyDelete: Boolean) {
+  issueDelete(id: $id, permanentlyDelete: $permanentlyDelete) {
+    success
+  }
+}`,
+		Variables: vars,
+	}
+}
+
+func issueCommentMutation(issueID, body string) Mutation {
+	return Mutation{
+		Query: `mutation($input: CommentCreateInput!) {
+  commentCreate(input: $input) {
+    comment { id }
+  }
+}`,
+		Variables: map[string]any{"input": map[string]any{"issueId": issueID, "body": body}},
+	}
+}
+
+func commentReplyMutation(issueID, parentID, body string) Mutation {
+	return Mutation{
+		Query: `mutation($input: CommentCreateInput!) {
+  commentCreate(input: $input) {
+    comment { id }
+  }
+}`,
+		Variables: map[string]any{"input": map[string]any{"issueId": issueID, "parentId": parentID, "body": body}},
+	}
+}
+
+func commentUpdateMutation(commentID, body string) Mutation {
+	return Mutation{
+		Query: `mutation($id: String!, $input: CommentUpdateInput!) {
+  commentUpdate(id: $id, input: $input) {
+    success
+  }
+}`,
+		Variables: map[string]any{"id": commentID, "input": map[string]any{"body": body}},
+	}
+}
+
+func commentResolveMutation(commentID string) Mutation {
+	return Mutation{
+		Query: `mutation($id: String!) {
+  commentResolve(id: $id) {
+    success
+  }
+}`,
+		Variables: map[string]any{"id": commentID},
+	}
+}
+
+func commentUnresolveMutation(commentID string) Mutation {
+	return Mutation{
+		Query: `mutation($id: String!) {
+  commentUnresolve(id: $id) {
+    success
+  }
+}`,
+		Variables: map[string]any{"id": commentID},
+	}
+}
+
+func issueSubscribeMutation(issueID, userID string) Mutation {
+	return issueSubscriptionMutation("issueSubscribe", issueID, userID)
+}
+
+func issueUnsubscribeMutation(issueID, userID string) Mutation {
+	return issueSubscriptionMutation("issueUnsubscribe", issueID, userID)
+}
+
+func issueSubscriptionMutation(field, issueID, userID string) Mutation {
+	vars := map[string]any{"id": issueID}
+	if userID != "" {
+		vars["userId"] = userID
+	}
+	return Mutation{
+		Query: `mutation($id: String!, $userId: String) {
+  ` + field + `(id: $id, userId: $userId) {
+    success
+  }
+}`,
+		Variables: vars,
+	}
+}
+
+func reactionCreateMutation(issueID, commentID, emoji string) Mutation {
+	input := map[string]any{"emoji": emoji}
+	if issueID != "" {
+		input["issueId"] = issueID
+	}
+	if commentID != "" {
+		input["commentId"] = commentID
+	}
+	return Mutation{
+		Query: `mutation($input: ReactionCreateInput!) {
+  reactionCreate(input: $input) {
+    reaction { ` + reactionFields + ` }
+  }
+}`,
+		Variables: map[string]any{"input": input},
+	}
+}
+
+func reactionDeleteMutation(reactionID string) Mutation {
+	return Mutation{
+		Query: `mutation($id: String!) {
+  reactionDelete(id: $id) {
+    success
+  }
+}`,
+		Variables: map[string]any{"id": reactionID},
+	}
+}
+
+func attachmentCreateMutation(input AttachmentCreateInput) Mutation {
+	return Mutation{
+		Query: `mutation($input: AttachmentCreateInput!) {
+  attachmentCreate(input: $input) {
+    attachment { ` + attachmentFields + ` }
+  }
+}`,
+		Variables: map[string]any{"input": input.Variables()},
+	}
+}
+
+func attachmentDeleteMutation(attachmentID string) Mutation {
+	return Mutation{
+		Query: `mutation($id: String!) {
+  attachmentDelete(id: $id) {
+    success
+  }
+}`,
+		Variables: map[string]any{"id": attachmentID},
+	}
+}
+
+func fileUploadMutation(filename, contentType string, size int64) Mutation {
+	return Mutation{
+		Query: `mutation($contentType: String!, $filename: String!, $size: Int!) {
+  fileUpload(contentType: $contentType, filename: $filename, size: $size) {
+    success
+    uploadFile { uploadUrl assetUrl headers { key value } }
+  }
+}`,
+		Variables: map[string]any{"contentType": contentType, "filename": filename, "size": size},
+	}
+}
+
+func issueRelationCreateMutation(issueID, relatedIssueID, relationType string) Mutation {
+	return Mutation{
+		Query: `mutation($input: IssueRelationCreateInput!) {
+  issueRelationCreate(input: $input) {
+    issueRelation { ` + issueRelationFields + ` }
+  }
+}`,
+		Variables: map[string]any{"input": map[string]any{
+			"issueId":        issueID,
+			"relatedIssueId": relatedIssueID,
+			"type":           relationType,
+		}},
+	}
+}
+
+func issueRelationDeleteMutation(relationID string) Mutation {
+	return Mutation{
+		Query: `mutation($id: String!) {
+  issueRelationDelete(id: $id) {
+    success
+  }
+}`,
+		Variables: map[string]any{"id": relationID},
+	}
+}
+
+func commentDeleteMutation(commentID string) Mutation {
+	return Mutation{
+		Query: `mutation($id: String!) {
+  commentDelete(id: $id) {
+    success
+  }
+}`,
+		Variables: map[string]any{"id": commentID},
+	}
+}
+
+func (c *Client) mutate(ctx context.Context, m Mutation, out any) error {
+	return c.do(ctx, m.Query, m.Variables, out)
+}
+
+func (c *Client) mutateSuccess(ctx context.Context, field string, m Mutation) error {
+	var resp map[string]*struct {
+		Success bool `json:"success"`
+	}
+	if err := c.mutate(ctx, m, &resp); err != nil {
+		return err
+	}
+	payload := resp[field]
+	if payload == nil {
+		return ErrNotFound
+	}
+	if !payload.Success {
+		return fmt.Errorf("%s failed", field)
+	}
+	return nil
+}
+
+func (c *Client) IssueCreate(ctx context.Context, input IssueCreateInput) (IssueSummary, error) {
+	if err := input.Validate(); err != nil {
+		return IssueSummary{}, err
+	}
+	var resp struct {
+		IssueCreate struct {
+			Issue *IssueSummary `json:"issue"`
+		} `json:"issueCreate"`
+	}
+	if err := c.mutate(ctx, issueCreateMutation(input), &resp); err != nil {
+		return IssueSummary{}, err
+	}
+	if resp.IssueCreate.Issue == nil {
+		return IssueSummary{}, ErrNotFound
+	}
+	return *resp.IssueCreate.Issue, nil
+}
+
+func (c *Client) IssueUpdate(ctx context.Context, issueID string, input IssueUpdateInput) (IssueSummary, error) {
+	if issueID == "" {
+		return IssueSummary{}, errors.New("issue id is required")
+	}
+	if err := input.Validate(); err != nil {
+		return IssueSummary{}, err
+	}
+	var resp struct {
+		IssueUpdate struct {
+			Issue *IssueSummary `json:"issue"`
+		} `json:"issueUpdate"`
+	}
+	if err := c.mutate(ctx, issueUpdateMutation(issueID, input), &resp); err != nil {
+		return IssueSummary{}, err
+	}
+	if resp.IssueUpdate.Issue == nil {
+		return IssueSummary{}, ErrNotFound
+	}
+	return *resp.IssueUpdate.Issue, nil
+}
+
+func (c *Client) IssueArchive(ctx context.Context, issueID string) error {
+	return c.mutateSuccess(ctx, "issueArchive", issueArchiveMutation(issueID))
+}
+
+func (c *Client) IssueUnarchive(ctx context.Context, issueID string) error {
+	return c.mutateSuccess(ctx, "issueUnarchive", issueUnarchiveMutation(issueID))
+}
+
+func (c *Client) IssueDelete(ctx context.Context, issueID string, permanent bool) error {
+	return c.mutateSuccess(ctx, "issueDelete", issueDeleteMutation(issueID, permanent))
+}
+
+func (c *Client) IssueComment(ctx context.Context, issueID, body string) (string, error) {
+	return c.createComment(ctx, issueCommentMutation(issueID, body))
+}
+
+func (c *Client) CommentReply(ctx context.Context, issueID, parentID, body string) (string, error) {
+	return c.createComment(ctx, commentReplyMutation(issueID, parentID, body))
+}
+
+func (c *Client) createComment(ctx context.Context, m Mutation) (string, error) {
+	var resp struct {
+		CommentCreate struct {
+			Comment *struct {
+				ID string `json:"id"`
+			} `json:"comment"`
+		} `json:"commentCreate"`
+	}
+	if err := c.mutate(ctx, m, &resp); err != nil {
+		return "", err
+	}
+	if resp.CommentCreate.Comment == nil {
+		return "", ErrNotFound
+	}
+	return resp.CommentCreate.Comment.ID, nil
+}
+
+func (c *Client) CommentUpdate(ctx context.Context, commentID, body string) error {
+	return c.mutateSuccess(ctx, "commentUpdate", commentUpdateMutation(commentID, body))
+}
+
+func (c *Client) CommentResolve(ctx context.Context, commentID string) error {
+	return c.mutateSuccess(ctx, "commentResolve", commentResolveMutation(commentID))
+}
+
+func (c *Client) CommentUnresolve(ctx context.Context, commentID string) error {
+	return c.mutateSuccess(ctx, "commentUnresolve", commentUnresolveMutation(commentID))
+}
+
+func (c *Client) CommentDelete(ctx context.Context, commentID string) error {
+	return c.mutateSuccess(ctx, "commentDelete", commentDeleteMutation(commentID))
+}
+
+func (c *Client) IssueSubscribe(ctx context.Context, issueID, userID string) error {
+	return c.mutateSuccess(ctx, "issueSubscribe", issueSubscribeMutation(issueID, userID))
+}
+
+func (c *Client) IssueUnsubscribe(ctx context.Context, issueID, userID string) error {
+	return c.mutateSuccess(ctx, "issueUnsubscribe", issueUnsubscribeMutation(issueID, userID))
+}
+
+func (c *Client) ReactionCreate(ctx context.Context, issueID, commentID, emoji string) (Reaction, error) {
+	if err := validateReaction(issueID, commentID, emoji); err != nil {
+		return Reaction{}, err
+	}
+	var resp struct {
+		ReactionCreate struct {
+			Reaction *reactionNode `json:"reaction"`
+		} `json:"reactionCreate"`
+	}
+	if err := c.mutate(ctx, reactionCreateMutation(issueID, commentID, emoji), &resp); err != nil {
+		return Reaction{}, err
+	}
+	if resp.ReactionCreate.Reaction == nil {
+		return Reaction{}, ErrNotFound
+	}
+	return resp.ReactionCreate.Reaction.reaction(), nil
+}
+
+func (c *Client) ReactionDelete(ctx context.Context, reactionID string) error {
+	return c.mutateSuccess(ctx, "reactionDelete", reactionDeleteMutation(reactionID))
+}
+
+func (c *Client) AttachmentCreate(ctx context.Context, input AttachmentCreateInput) (Attachment, error) {
+	if err := input.Validate(); err != nil {
+		return Attachment{}, err
+	}
+	var resp struct {
+		AttachmentCreate struct {
+			Attachment *attachmentNode `json:"attachment"`
+		} `json:"attachmentCreate"`
+	}
+	if err := c.mutate(ctx, attachmentCreateMutation(input), &resp); err != nil {
+		return Attachment{}, err
+	}
+	if resp.AttachmentCreate.Attachment == nil {
+		return Attachment{}, ErrNotFound
+	}
+	return resp.AttachmentCreate.Attachment.attachment(), nil
+}
+
+func (c *Client) AttachmentDelete(ctx context.Context, attachmentID string) error {
+	return c.mutateSuccess(ctx, "attachmentDelete", attachmentDeleteMutation(attachmentID))
+}
+
+func (c *Client) IssueRelationCreate(ctx context.Context, issueID, relatedIssueID, relationType string) (IssueRelation, error) {
+	var resp struct {
+		IssueRelationCreate struct {
+			IssueRelation *issueRelationNode `json:"issueRelation"`
+		} `json:"issueRelationCreate"`
+	}
+	if err := c.mutate(ctx, issueRelationCreateMutation(issueID, relatedIssueID, relationType), &resp); err != nil {
+		return IssueRelation{}, err
+	}
+	if resp.IssueRelationCreate.IssueRelation == nil {
+		return IssueRelation{}, ErrNotFound
+	}
+	return resp.IssueRelationCreate.IssueRelation.relation(), nil
+}
+
+func (c *Client) IssueRelationDelete(ctx context.Context, relationID string) error {
+	return c.mutateSuccess(ctx, "issueRelationDelete", issueRelationDeleteMutation(relationID))
+}
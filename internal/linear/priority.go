@@ -0,0 +1,102 @@
+package linear
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Priority int
+
+const (
+	PriorityNone Priority = iota
+	PriorityUrgent
+	PriorityHigh
+	PriorityMedium
+	PriorityLow
+)
+
+var priorityNames = map[Priority]string{
+	PriorityNone:   "None",
+	PriorityUrgent: "Urgent",
+	PriorityHigh:   "High",
+	PriorityMedium: "Medium",
+	PriorityLow:    "Low",
+}
+
+func (p Priority) String() string {
+	if name, ok := priorityNames[p]; ok {
+		return name
+	}
+	return strconv.Itoa(int(p))
+}
+
+func (p Priority) Valid() bool {
+	_, ok := priorityNames[p]
+	return ok
+}
+
+// rank orders priorities by importance: none < low < medium < high < urgent.
+func (p Priority) rank() int {
+	if p == PriorityNone {
+		return 0
+	}
+	return int(PriorityLow) - int(p) + 1
+}
+
+func ParsePriority(value string) (Priority, error) {
+	value = strings.TrimSpace(value)
+	if n, err := strconv.Atoi(value); err == nil {
+		p := Priority(n)
+		if !p.Valid() {
+			return 0, fmt.Errorf("invalid priority %q (expected 0-4)", value)
+		}
+		return p, nil
+	}
+	switch strings.ToLower(value) {
+	case "none", "no priority":
+		return PriorityNone, nil
+	case "urgent":
+		return PriorityUrgent, nil
+	case "high":
+		return PriorityHigh, nil
+	case "medium":
+		return PriorityMedium, nil
+	case "low":
+		return PriorityLow, nil
+	}
+	return 0, fmt.Errorf("invalid priority %q (expected none, urgent, high, medium, low, or 0-4)", value)
+}
+
+// ParsePriorityRange parses a single priority or an inclusive range such as
+// "high..urgent". Range bounds may be given in either order.
+func ParsePriorityRange(value string) ([]Priority, error) {
+	from, to, isRange := strings.Cut(value, "..")
+	if !isRange {
+		p, err := ParsePriority(value)
+		if err != nil {
+			return nil, err
+		}
+		return []Priority{p}, nil
+	}
+	low, err := ParsePriority(from)
+	if err != nil {
+		return nil, err
+	}
+	high, err := ParsePriority(to)
+	if err != nil {
+		return nil, err
+	}
+	if low.rank() > high.rank() {
+		low, high = high, low
+	}
+	out := []Priority{}
+	for p := range priorityNames {
+		if p.rank() >= low.rank() && p.rank() <= high.rank() {
+			out = append(out, p)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, nil
+}
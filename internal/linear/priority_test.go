@@ -0,0 +1,65 @@
+package linear
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePriority(t *testing.T) {
+	cases := map[string]Priority{
+		"none":   PriorityNone,
+		"Urgent": PriorityUrgent,
+		"high":   PriorityHigh,
+		"3":      PriorityMedium,
+		" low ":  PriorityLow,
+	}
+	for input, want := range cases {
+		got, err := ParsePriority(input)
+		if err != nil {
+			t.Fatalf("ParsePriority(%q) error: %v", input, err)
+		}
+		if got != want {
+			t.Fatalf("ParsePriority(%q) = %v, want %v", input, got, want)
+		}
+	}
+	for _, input := range []string{"5", "-1", "critical", ""} {
+		if _, err := ParsePriority(input); err == nil {
+			t.Fatalf("expected error for %q", input)
+		}
+	}
+}
+
+func TestParsePriorityRange(t *testing.T) {
+	got, err := ParsePriorityRange("high..urgent")
+	if err != nil {
+		t.Fatalf("ParsePriorityRange error: %v", err)
+	}
+	if want := []Priority{PriorityUrgent, PriorityHigh}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	got, err = ParsePriorityRange("urgent..low")
+	if err != nil {
+		t.Fatalf("ParsePriorityRange error: %v", err)
+	}
+	if want := []Priority{PriorityUrgent, PriorityHigh, PriorityMedium, PriorityLow}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	got, err = ParsePriorityRange("medium")
+	if err != nil {
+		t.Fatalf("ParsePriorityRange error: %v", err)
+	}
+	if want := []Priority{PriorityMedium}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestPriorityString(t *testing.T) {
+	if PriorityHigh.String() != "High" {
+		t.Fatalf("expected High, got %s", PriorityHigh.String())
+	}
+	if PriorityNone.String() != "None" {
+		t.Fatalf("expected None, got %s", PriorityNone.String())
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"path"
 	"regexp"
 	"strings"
+	"time"
 )
 
 const (
@@ -82,6 +83,144 @@ func (c *Client) Teams(ctx context.Context) ([]Team, error) {
 	return resp.Teams.Nodes, nil
 }
 
+func (c *Client) Users(ctx context.Context) ([]User, error) {
+	query := `query {
+  users(first: 250, filter: { active: { eq: true } }) {
+    nodes { id name email }
+  }
+}`
+	var resp struct {
+		Users struct {
+			Nodes []User `json:"nodes"`
+		} `json:"users"`
+	}
+	if err := c.do(ctx, query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Users.Nodes, nil
+}
+
+func (c *Client) IssueLabels(ctx context.Context, teamID string) ([]Label, error) {
+	query := `query($filter: IssueLabelFilter) {
+  issueLabels(first: 250, filter: $filter) {
+    nodes { id name team { id } }
+  }
+}`
+	var filter map[string]any
+	if teamID != "" {
+		filter = map[string]any{"or": []map[string]any{
+			{"team": map[string]any{"id": map[string]any{"eq": teamID}}},
+			{"team": map[string]any{"null": true}},
+		}}
+	}
+	var resp struct {
+		IssueLabels struct {
+			Nodes []struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+				Team *struct {
+					ID string `json:"id"`
+				} `json:"team"`
+			} `json:"nodes"`
+		} `json:"issueLabels"`
+	}
+	if err := c.do(ctx, query, map[string]any{"filter": filter}, &resp); err != nil {
+		return nil, err
+	}
+	labels := make([]Label, 0, len(resp.IssueLabels.Nodes))
+	for _, node := range resp.IssueLabels.Nodes {
+		label := Label{ID: node.ID, Name: node.Name}
+		if node.Team != nil {
+			label.TeamID = node.Team.ID
+		}
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
+func (c *Client) Projects(ctx context.Context) ([]Project, error) {
+	query := `query {
+  projects(first: 250) {
+    nodes { id name }
+  }
+}`
+	var resp struct {
+		Projects struct {
+			Nodes []Project `json:"nodes"`
+		} `json:"projects"`
+	}
+	if err := c.do(ctx, query, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Projects.Nodes, nil
+}
+
+func (c *Client) IssueTemplates(ctx context.Context) ([]IssueTemplate, error) {
+	query := `query {
+  templates {
+    id
+    name
+    type
+    team { id key }
+    templateData
+  }
+}`
+	var resp struct {
+		Templates []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			Type string `json:"type"`
+			Team *struct {
+				ID  string `json:"id"`
+				Key string `json:"key"`
+			} `json:"team"`
+			TemplateData json.RawMessage `json:"templateData"`
+		} `json:"templates"`
+	}
+	if err := c.do(ctx, query, nil, &resp); err != nil {
+		return nil, err
+	}
+	templates := []IssueTemplate{}
+	for _, node := range resp.Templates {
+		if node.Type != "issue" {
+			continue
+		}
+		var data struct {
+			Title       string    `json:"title"`
+			Description string    `json:"description"`
+			Priority    *Priority `json:"priority"`
+			Estimate    *float64  `json:"estimate"`
+			StateID     string    `json:"stateId"`
+			AssigneeID  string    `json:"assigneeId"`
+			ProjectID   string    `json:"projectId"`
+			LabelIDs    []string  `json:"labelIds"`
+		}
+		if len(node.TemplateData) > 0 && string(node.TemplateData) != "null" {
+			if err := json.Unmarshal(node.TemplateData, &data); err != nil {
+				return nil, fmt.Errorf("decode template %q: %w", node.Name, err)
+			}
+		}
+		template := IssueTemplate{
+			ID:          node.ID,
+			Name:        node.Name,
+			Title:       data.Title,
+			Description: data.Description,
+			Priority:    data.Priority,
+			Estimate:    data.Estimate,
+			StateID:     data.StateID,
+			AssigneeID:  data.AssigneeID,
+			ProjectID:   data.ProjectID,
+			LabelIDs:    data.LabelIDs,
+		}
+		if node.Team != nil {
+			template.TeamID = node.Team.ID
+			template.TeamKey = node.Team.Key
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
 func (c *Client) ResolveTeamID(ctx context.Context, keyOrID string) (string, error) {
 	if isLikelyID(keyOrID) {
 		team, err := c.teamByID(ctx, keyOrID)
@@ -164,7 +303,7 @@ func (c *Client) ResolveUserID(ctx context.Context, value string) (string, error
 		}
 		return resp.Users.Nodes[0].ID, nil
 	}
-	return "", fmt.Errorf("assignee must be 'me', an id, or an email")
+	return "", fmt.Errorf("user must be 'me', an id, or an email")
 }
 
 func (c *Client) WorkflowStates(ctx context.Context, teamID string) ([]WorkflowState, error) {
@@ -207,7 +346,7 @@ func (c *Client) ResolveStateID(ctx context.Context, teamID, value string) (stri
 	return "", ErrNotFound
 }
 
-func (c *Client) ResolveLabelIDs(ctx context.Context, labels []string) ([]string, error) {
+func (c *Client) ResolveLabelIDs(ctx context.Context, teamID string, labels []string) ([]string, error) {
 	if len(labels) == 0 {
 		return nil, nil
 	}
@@ -220,8 +359,15 @@ func (c *Client) ResolveLabelIDs(ctx context.Context, labels []string) ([]string
 			ids = append(ids, label)
 			continue
 		}
-		query := `query($name: String!) {
-  issueLabels(filter: { name: { eq: $name } }) {
+		filter := map[string]any{"name": map[string]any{"eq": label}}
+		if teamID != "" {
+			filter["or"] = []map[string]any{
+				{"team": map[string]any{"id": map[string]any{"eq": teamID}}},
+				{"team": map[string]any{"null": true}},
+			}
+		}
+		query := `query($filter: IssueLabelFilter) {
+  issueLabels(filter: $filter) {
     nodes { id }
   }
 }`
@@ -232,11 +378,11 @@ func (c *Client) ResolveLabelIDs(ctx context.Context, labels []string) ([]string
 				} `json:"nodes"`
 			} `json:"issueLabels"`
 		}
-		if err := c.do(ctx, query, map[string]any{"name": label}, &resp); err != nil {
+		if err := c.do(ctx, query, map[string]any{"filter": filter}, &resp); err != nil {
 			return nil, err
 		}
 		if len(resp.IssueLabels.Nodes) == 0 {
-			return nil, ErrNotFound
+			return nil, fmt.Errorf("label %q: %w", label, ErrNotFound)
 		}
 		ids = append(ids, resp.IssueLabels.Nodes[0].ID)
 	}
@@ -306,53 +452,38 @@ func (c *Client) ResolveIssueID(ctx context.Context, value string) (string, erro
 func (c *Client) Issue(ctx context.Context, value string) (IssueDetail, error) {
 	query := `query($id: String!) {
   issue(id: $id) {
-    id
-    identifier
-    title
-    url
+    ` + issueSummaryFields + `
     description
-    priority
-    createdAt
-    updatedAt
-    team { id key }
-    state { name }
-    assignee { name }
-    cycle { name }
-    project { name }
-    labels { nodes { name } }
+    branchName
+    slaType
+    slaStartedAt
+    slaBreachesAt
+    startedAt
+    completedAt
+    canceledAt
+    subscribers { nodes { id name email } }
+    children { nodes { ` + issueSummaryFields + ` } }
+    reactions { ` + reactionFields + ` }
   }
 }`
 	var resp struct {
 		Issue *struct {
-			ID          string `json:"id"`
-			Identifier  string `json:"identifier"`
-			Title       string `json:"title"`
-			URL         string `json:"url"`
-			Description string `json:"description"`
-			Priority    int    `json:"priority"`
-			CreatedAt   string `json:"createdAt"`
-			UpdatedAt   string `json:"updatedAt"`
-			Team        struct {
-				ID  string `json:"id"`
-				Key string `json:"key"`
-			} `json:"team"`
-			State struct {
-				Name string `json:"name"`
-			} `json:"state"`
-			Assignee *struct {
-				Name string `json:"name"`
-			} `json:"assignee"`
-			Cycle *struct {
-				Name string `json:"name"`
-			} `json:"cycle"`
-			Project *struct {
-				Name string `json:"name"`
-			} `json:"project"`
-			Labels struct {
-				Nodes []struct {
-					Name string `json:"name"`
-				} `json:"nodes"`
-			} `json:"labels"`
+			issueSummaryNode
+			Description   string     `json:"description"`
+			BranchName    string     `json:"branchName"`
+			SLAType       string     `json:"slaType"`
+			SLAStartedAt  *time.Time `json:"slaStartedAt"`
+			SLABreachesAt *time.Time `json:"slaBreachesAt"`
+			StartedAt     *time.Time `json:"startedAt"`
+			CompletedAt   *time.Time `json:"completedAt"`
+			CanceledAt    *time.Time `json:"canceledAt"`
+			Subscribers   struct {
+				Nodes []User `json:"nodes"`
+			} `json:"subscribers"`
+			Children struct {
+				Nodes []issueSummaryNode `json:"nodes"`
+			} `json:"children"`
+			Reactions []reactionNode `json:"reactions"`
 		} `json:"issue"`
 	}
 	if err := c.do(ctx, query, map[string]any{"id": value}, &resp); err != nil {
@@ -362,49 +493,93 @@ func (c *Client) Issue(ctx context.Context, value string) (IssueDetail, error) {
 		return IssueDetail{}, ErrNotFound
 	}
 
-	labels := make([]string, 0, len(resp.Issue.Labels.Nodes))
+	summary := resp.Issue.summary()
+	labels := summary.Labels
+	if labels == nil {
+		labels = []string{}
+	}
+	labelIDs := make([]string, 0, len(resp.Issue.Labels.Nodes))
 	for _, label := range resp.Issue.Labels.Nodes {
-		labels = append(labels, label.Name)
-	}
-
-	var assignee string
-	if resp.Issue.Assignee != nil {
-		assignee = resp.Issue.Assignee.Name
-	}
-
-	var cycle string
-	if resp.Issue.Cycle != nil {
-		cycle = resp.Issue.Cycle.Name
-	}
-	var project string
-	if resp.Issue.Project != nil {
-		project = resp.Issue.Project.Name
-	}
-
-	return IssueDetail{
-		ID:          resp.Issue.ID,
-		Identifier:  resp.Issue.Identifier,
-		Title:       resp.Issue.Title,
-		URL:         resp.Issue.URL,
-		Description: resp.Issue.Description,
-		Priority:    resp.Issue.Priority,
-		State:       resp.Issue.State.Name,
-		Assignee:    assignee,
-		TeamID:      resp.Issue.Team.ID,
-		TeamKey:     resp.Issue.Team.Key,
-		Cycle:       cycle,
-		Project:     project,
-		Labels:      labels,
-		CreatedAt:   resp.Issue.CreatedAt,
-		UpdatedAt:   resp.Issue.UpdatedAt,
-	}, nil
+		labelIDs = append(labelIDs, label.ID)
+	}
+	detail := IssueDetail{
+		ID:            summary.ID,
+		Identifier:    summary.Identifier,
+		Title:         summary.Title,
+		URL:           summary.URL,
+		Description:   resp.Issue.Description,
+		Priority:      summary.Priority,
+		State:         summary.State,
+		StateType:     summary.StateType,
+		Assignee:      summary.Assignee,
+		TeamID:        summary.TeamID,
+		TeamKey:       summary.TeamKey,
+		Cycle:         summary.Cycle,
+		Project:       summary.Project,
+		Labels:        labels,
+		LabelIDs:      labelIDs,
+		StateID:       resp.Issue.State.ID,
+		AssigneeID:    resp.Issue.Assignee.id(),
+		ProjectID:     resp.Issue.Project.id(),
+		CycleID:       resp.Issue.Cycle.id(),
+		Estimate:      summary.Estimate,
+		DueDate:       summary.DueDate,
+		Creator:       summary.Creator,
+		Parent:        summary.Parent,
+		BranchName:    resp.Issue.BranchName,
+		Subscribers:   resp.Issue.Subscribers.Nodes,
+		SLAType:       resp.Issue.SLAType,
+		SLAStartedAt:  resp.Issue.SLAStartedAt,
+		SLABreachesAt: resp.Issue.SLABreachesAt,
+		StartedAt:     resp.Issue.StartedAt,
+		CompletedAt:   resp.Issue.CompletedAt,
+		CanceledAt:    resp.Issue.CanceledAt,
+		CreatedAt:     summary.CreatedAt,
+		UpdatedAt:     summary.UpdatedAt,
+	}
+	if resp.Issue.Parent != nil {
+		detail.ParentID = resp.Issue.Parent.ID
+	}
+	for _, child := range resp.Issue.Children.Nodes {
+		detail.SubIssues = append(detail.SubIssues, child.summary())
+	}
+	detail.Reactions = reactions(resp.Issue.Reactions)
+	return detail, nil
+}
+
+func (c *Client) IssueChildren(ctx context.Context, issueID string, limit int) ([]IssueSummary, error) {
+	query := `query($id: String!, $first: Int) {
+  issue(id: $id) {
+    children(first: $first) {
+      nodes { ` + issueSummaryFields + ` }
+    }
+  }
+}`
+	var resp struct {
+		Issue *struct {
+			Children struct {
+				Nodes []issueSummaryNode `json:"nodes"`
+			} `json:"children"`
+		} `json:"issue"`
+	}
+	if err := c.do(ctx, query, map[string]any{"id": issueID, "first": limit}, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Issue == nil {
+		return nil, ErrNotFound
+	}
+	children := make([]IssueSummary, 0, len(resp.Issue.Children.Nodes))
+	for _, node := range resp.Issue.Children.Nodes {
+		children = append(children, node.summary())
+	}
+	return children, nil
 }
 
 func (c *Client) IssueComments(ctx context.Context, issueID string, limit int) ([]Comment, error) {
 	query := `query($id: String!, $first: Int) {
   issue(id: $id) {
     comments(first: $first) {
-      nodes { id body bodyData createdAt user { name email } }
+      nodes { id body bodyData createdAt user { name email } reactions { ` + reactionFields + ` } }
     }
   }
 }`
@@ -421,6 +596,7 @@ func (c *Client) IssueComments(ctx context.Context, issueID string, limit int) (
 						Name  string `json:"name"`
 						Email string `json:"email"`
 					} `json:"user"`
+					Reactions []reactionNode `json:"reactions"`
 				} `json:"nodes"`
 			} `json:"comments"`
 		} `json:"issue"`
@@ -444,6 +620,7 @@ func (c *Client) IssueComments(ctx context.Context, issueID string, limit int) (
 			CreatedAt: node.CreatedAt,
 			Body:      node.Body,
 			BodyData:  node.BodyData,
+			Reactions: reactions(node.Reactions),
 		}
 		if node.User != nil {
 			comment.UserName = node.User.Name
@@ -459,7 +636,7 @@ func (c *Client) IssueUploads(ctx context.Context, issueID string, limit int) ([
   issue(id: $id) {
     description
     attachments(first: $first) {
-      nodes { id title url createdAt }
+      nodes { ` + attachmentFields + ` }
     }
   }
 }`
@@ -468,12 +645,7 @@ func (c *Client) IssueUploads(ctx context.Context, issueID string, limit int) ([
 		Issue *struct {
 			Description string `json:"description"`
 			Attachments struct {
-				Nodes []struct {
-					ID        string `json:"id"`
-					Title     string `json:"title"`
-					URL       string `json:"url"`
-					CreatedAt string `json:"createdAt"`
-				} `json:"nodes"`
+				Nodes []attachmentNode `json:"nodes"`
 			} `json:"attachments"`
 		} `json:"issue"`
 	}
@@ -507,13 +679,8 @@ func (c *Client) IssueUploads(ctx context.Context, issueID string, limit int) ([
 		}
 		uploads = append(uploads, item)
 	}
-	for _, item := range resp.Issue.Attachments.Nodes {
-		addAttachment(Attachment{
-			ID:        item.ID,
-			Title:     item.Title,
-			URL:       item.URL,
-			CreatedAt: item.CreatedAt,
-		})
+	for _, node := range resp.Issue.Attachments.Nodes {
+		addAttachment(node.attachment())
 	}
 	if resp.Issue.Description != "" {
 		for _, item := range parseUploadsLinks(resp.Issue.Description) {
@@ -534,28 +701,18 @@ func (c *Client) IssueUploads(ctx context.Context, issueID string, limit int) ([
 func (c *Client) IssueRelations(ctx context.Context, issueID string, limit int) (IssueRelationSet, error) {
 	query := `query($id: String!, $first: Int) {
   issue(id: $id) {
-    relations(first: $first) { nodes { id type issue { id } relatedIssue { id } } }
-    inverseRelations(first: $first) { nodes { id type issue { id } relatedIssue { id } } }
+    relations(first: $first) { nodes { ` + issueRelationFields + ` } }
+    inverseRelations(first: $first) { nodes { ` + issueRelationFields + ` } }
   }
 }`
 
-	type relationNode struct {
-		ID    string `json:"id"`
-		Type  string `json:"type"`
-		Issue struct {
-			ID string `json:"id"`
-		} `json:"issue"`
-		RelatedIssue struct {
-			ID string `json:"id"`
-		} `json:"relatedIssue"`
-	}
 	var resp struct {
 		Issue *struct {
 			Relations *struct {
-				Nodes []relationNode `json:"nodes"`
+				Nodes []issueRelationNode `json:"nodes"`
 			} `json:"relations"`
 			InverseRelations *struct {
-				Nodes []relationNode `json:"nodes"`
+				Nodes []issueRelationNode `json:"nodes"`
 			} `json:"inverseRelations"`
 		} `json:"issue"`
 	}
@@ -577,90 +734,18 @@ func (c *Client) IssueRelations(ctx context.Context, issueID string, limit int)
 	}
 	if resp.Issue.Relations != nil {
 		for _, node := range resp.Issue.Relations.Nodes {
-			result.Relations = append(result.Relations, IssueRelation{
-				ID:             node.ID,
-				IssueID:        node.Issue.ID,
-				RelatedIssueID: node.RelatedIssue.ID,
-				Type:           node.Type,
-			})
+			result.Relations = append(result.Relations, node.relation())
 		}
 	}
 	if resp.Issue.InverseRelations != nil {
 		for _, node := range resp.Issue.InverseRelations.Nodes {
-			result.InverseRelations = append(result.InverseRelations, IssueRelation{
-				ID:             node.ID,
-				IssueID:        node.Issue.ID,
-				RelatedIssueID: node.RelatedIssue.ID,
-				Type:           node.Type,
-			})
+			result.InverseRelations = append(result.InverseRelations, node.relation())
 		}
 	}
 
 	return result, nil
 }
 
-func (c *Client) IssueRelationCreate(ctx context.Context, issueID, relatedIssueID, relationType string) (IssueRelation, error) {
-	query := `mutation($input: IssueRelationCreateInput!) {
-  issueRelationCreate(input: $input) {
-    issueRelation { id type issue { id } relatedIssue { id } }
-  }
-}`
-	var resp struct {
-		IssueRelationCreate struct {
-			IssueRelation *struct {
-				ID    string `json:"id"`
-				Type  string `json:"type"`
-				Issue struct {
-					ID string `json:"id"`
-				} `json:"issue"`
-				RelatedIssue struct {
-					ID string `json:"id"`
-				} `json:"relatedIssue"`
-			} `json:"issueRelation"`
-		} `json:"issueRelationCreate"`
-	}
-	input := map[string]any{
-		"issueId":        issueID,
-		"relatedIssueId": relatedIssueID,
-		"type":           relationType,
-	}
-	if err := c.do(ctx, query, map[string]any{"input": input}, &resp); err != nil {
-		return IssueRelation{}, err
-	}
-	if resp.IssueRelationCreate.IssueRelation == nil {
-		return IssueRelation{}, ErrNotFound
-	}
-	return IssueRelation{
-		ID:             resp.IssueRelationCreate.IssueRelation.ID,
-		IssueID:        resp.IssueRelationCreate.IssueRelation.Issue.ID,
-		RelatedIssueID: resp.IssueRelationCreate.IssueRelation.RelatedIssue.ID,
-		Type:           resp.IssueRelationCreate.IssueRelation.Type,
-	}, nil
-}
-
-func (c *Client) IssueRelationDelete(ctx context.Context, relationID string) error {
-	query := `mutation($id: String!) {
-  issueRelationDelete(id: $id) {
-    success
-  }
-}`
-	var resp struct {
-		IssueRelationDelete *struct {
-			Success bool `json:"success"`
-		} `json:"issueRelationDelete"`
-	}
-	if err := c.do(ctx, query, map[string]any{"id": relationID}, &resp); err != nil {
-		return err
-	}
-	if resp.IssueRelationDelete == nil {
-		return ErrNotFound
-	}
-	if !resp.IssueRelationDelete.Success {
-		return fmt.Errorf("relation delete failed")
-	}
-	return nil
-}
-
 func extractAttachmentsFromComments(comments []Comment) []Attachment {
 	seen := map[string]struct{}{}
 	attachments := []Attachment{}
@@ -810,23 +895,171 @@ var (
 	uploadURLRe          = regexp.MustCompile(`https?://uploads\.linear\.app/[^\s\)]+`)
 )
 
-func (c *Client) Issues(ctx context.Context, filter IssueFilter, limit int, after string) (IssuePage, error) {
-	query := `query($filter: IssueFilter, $first: Int, $after: String) {
-  issues(filter: $filter, first: $first, after: $after) {
-    nodes {
-      id
+const issueRefFields = `id identifier title state { name type }`
+
+const issueRelationFields = `id type issue { ` + issueRefFields + ` } relatedIssue { ` + issueRefFields + ` }`
+
+type issueRefNode struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	State      *struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"state"`
+}
+
+func (n issueRefNode) ref() IssueRef {
+	ref := IssueRef{ID: n.ID, Identifier: n.Identifier, Title: n.Title}
+	if n.State != nil {
+		ref.State = n.State.Name
+		ref.StateType = n.State.Type
+	}
+	return ref
+}
+
+type issueRelationNode struct {
+	ID           string       `json:"id"`
+	Type         string       `json:"type"`
+	Issue        issueRefNode `json:"issue"`
+	RelatedIssue issueRefNode `json:"relatedIssue"`
+}
+
+func (n issueRelationNode) relation() IssueRelation {
+	return IssueRelation{
+		ID:             n.ID,
+		IssueID:        n.Issue.ID,
+		RelatedIssueID: n.RelatedIssue.ID,
+		Type:           n.Type,
+		Issue:          n.Issue.ref(),
+		RelatedIssue:   n.RelatedIssue.ref(),
+	}
+}
+
+const issueSummaryFields = `id
       identifier
       title
       url
       priority
-      state { name }
-      assignee { name }
-      team { key }
-      cycle { name }
-    }
-    pageInfo { hasNextPage endCursor }
-  }
-}`
+      estimate
+      dueDate
+      createdAt
+      updatedAt
+      state { id name type }
+      assignee { id name }
+      creator { name }
+      team { id key }
+      cycle { id name }
+      project { id name }
+      parent { id identifier }
+      labels { nodes { id name } }`
+
+type issueSummaryNode struct {
+	ID         string    `json:"id"`
+	Identifier string    `json:"identifier"`
+	Title      string    `json:"title"`
+	URL        string    `json:"url"`
+	Priority   Priority  `json:"priority"`
+	Estimate   *float64  `json:"estimate"`
+	DueDate    *Date     `json:"dueDate"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	State      struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"state"`
+	Assignee *namedNode `json:"assignee"`
+	Creator  *namedNode `json:"creator"`
+	Team     struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	} `json:"team"`
+	Cycle   *namedNode `json:"cycle"`
+	Project *namedNode `json:"project"`
+	Parent  *struct {
+		ID         string `json:"id"`
+		Identifier string `json:"identifier"`
+	} `json:"parent"`
+	Labels struct {
+		Nodes []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"labels"`
+}
+
+type namedNode struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (n *namedNode) name() string {
+	if n == nil {
+		return ""
+	}
+	return n.Name
+}
+
+func (n *namedNode) id() string {
+	if n == nil {
+		return ""
+	}
+	return n.ID
+}
+
+type issueConnection struct {
+	Nodes    []issueSummaryNode `json:"nodes"`
+	PageInfo struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	} `json:"pageInfo"`
+}
+
+func (node issueSummaryNode) summary() IssueSummary {
+	summary := IssueSummary{
+		ID:         node.ID,
+		Identifier: node.Identifier,
+		Title:      node.Title,
+		URL:        node.URL,
+		State:      node.State.Name,
+		StateType:  node.State.Type,
+		Assignee:   node.Assignee.name(),
+		TeamID:     node.Team.ID,
+		TeamKey:    node.Team.Key,
+		Cycle:      node.Cycle.name(),
+		Project:    node.Project.name(),
+		Priority:   node.Priority,
+		Estimate:   node.Estimate,
+		DueDate:    node.DueDate,
+		Creator:    node.Creator.name(),
+		CreatedAt:  node.CreatedAt,
+		UpdatedAt:  node.UpdatedAt,
+	}
+	if node.Parent != nil {
+		summary.Parent = node.Parent.Identifier
+	}
+	if len(node.Labels.Nodes) > 0 {
+		summary.Labels = make([]string, 0, len(node.Labels.Nodes))
+		for _, label := range node.Labels.Nodes {
+			summary.Labels = append(summary.Labels, label.Name)
+		}
+	}
+	return summary
+}
+
+func (conn issueConnection) page() IssuePage {
+	page := IssuePage{
+		Nodes:    make([]IssueSummary, 0, len(conn.Nodes)),
+		PageInfo: PageInfo{HasNextPage: conn.PageInfo.HasNextPage, EndCursor: conn.PageInfo.EndCursor},
+	}
+	for _, node := range conn.Nodes {
+		page.Nodes = append(page.Nodes, node.summary())
+	}
+	return page
+}
+
+func issuePageVariables(filter IssueFilter, limit int, after string) map[string]any {
 	vars := map[string]any{}
 	if limit > 0 {
 		vars["first"] = limit
@@ -835,137 +1068,123 @@ func (c *Client) Issues(ctx context.Context, filter IssueFilter, limit int, afte
 		vars["after"] = after
 	}
 	vars["filter"] = buildIssueFilter(filter)
-
-	var resp struct {
-		Issues struct {
-			Nodes []struct {
-				ID         string `json:"id"`
-				Identifier string `json:"identifier"`
-				Title      string `json:"title"`
-				URL        string `json:"url"`
-				Priority   int    `json:"priority"`
-				State      struct {
-					Name string `json:"name"`
-				} `json:"state"`
-				Assignee *struct {
-					Name string `json:"name"`
-				} `json:"assignee"`
-				Team struct {
-					Key string `json:"key"`
-				} `json:"team"`
-				Cycle *struct {
-					Name string `json:"name"`
-				} `json:"cycle"`
-			} `json:"nodes"`
-			PageInfo struct {
-				HasNextPage bool   `json:"hasNextPage"`
-				EndCursor   string `json:"endCursor"`
-			} `json:"pageInfo"`
-		} `json:"issues"`
-	}
-	if err := c.do(ctx, query, vars, &resp); err != nil {
-		return IssuePage{}, err
+	if filter.IncludeArchived {
+		vars["includeArchived"] = true
 	}
-
-	page := IssuePage{
-		Nodes:    make([]IssueSummary, 0, len(resp.Issues.Nodes)),
-		PageInfo: PageInfo{HasNextPage: resp.Issues.PageInfo.HasNextPage, EndCursor: resp.Issues.PageInfo.EndCursor},
-	}
-	for _, node := range resp.Issues.Nodes {
-		assignee := ""
-		if node.Assignee != nil {
-			assignee = node.Assignee.Name
-		}
-		cycle := ""
-		if node.Cycle != nil {
-			cycle = node.Cycle.Name
-		}
-		page.Nodes = append(page.Nodes, IssueSummary{
-			ID:         node.ID,
-			Identifier: node.Identifier,
-			Title:      node.Title,
-			URL:        node.URL,
-			State:      node.State.Name,
-			Assignee:   assignee,
-			TeamKey:    node.Team.Key,
-			Cycle:      cycle,
-			Priority:   node.Priority,
-		})
-	}
-	return page, nil
+	return vars
 }
 
-func (c *Client) IssueCreate(ctx context.Context, input map[string]any) (IssueSummary, error) {
-	query := `mutation($input: IssueCreateInput!) {
-  issueCreate(input: $input) {
-    issue { id identifier title url }
+func (c *Client) Issues(ctx context.Context, filter IssueFilter, limit int, after string) (IssuePage, error) {
+	query := `query($filter: IssueFilter, $first: Int, $after: String, $includeArchived: Boolean) {
+  issues(filter: $filter, first: $first, after: $after, includeArchived: $includeArchived) {
+    nodes {
+      ` + issueSummaryFields + `
+    }
+    pageInfo { hasNextPage endCursor }
   }
 }`
 	var resp struct {
-		IssueCreate struct {
-			Issue *IssueSummary `json:"issue"`
-		} `json:"issueCreate"`
-	}
-	if err := c.do(ctx, query, map[string]any{"input": input}, &resp); err != nil {
-		return IssueSummary{}, err
+		Issues issueConnection `json:"issues"`
 	}
-	if resp.IssueCreate.Issue == nil {
-		return IssueSummary{}, ErrNotFound
+	if err := c.do(ctx, query, issuePageVariables(filter, limit, after), &resp); err != nil {
+		return IssuePage{}, err
 	}
-	return *resp.IssueCreate.Issue, nil
+	return resp.Issues.page(), nil
 }
 
-func (c *Client) IssueUpdate(ctx context.Context, input map[string]any) (IssueSummary, error) {
-	id, _ := input["id"].(string)
-	if id == "" {
-		return IssueSummary{}, errors.New("issue id is required")
-	}
-	trimmed := map[string]any{}
-	for key, value := range input {
-		if key == "id" {
-			continue
-		}
-		trimmed[key] = value
-	}
-	query := `mutation($id: String!, $input: IssueUpdateInput!) {
-  issueUpdate(id: $id, input: $input) {
-    issue { id identifier title url }
+// CustomViews pages through every custom view visible to the user, so name
+// lookups see views past the first page.
+func (c *Client) CustomViews(ctx context.Context) ([]CustomView, error) {
+	query := `query($first: Int, $after: String) {
+  customViews(first: $first, after: $after) {
+    nodes { id name description shared team { key } }
+    pageInfo { hasNextPage endCursor }
   }
 }`
-	var resp struct {
-		IssueUpdate struct {
-			Issue *IssueSummary `json:"issue"`
-		} `json:"issueUpdate"`
+	views := []CustomView{}
+	vars := map[string]any{"first": 250}
+	for {
+		var resp struct {
+			CustomViews struct {
+				Nodes []struct {
+					ID          string `json:"id"`
+					Name        string `json:"name"`
+					Description string `json:"description"`
+					Shared      bool   `json:"shared"`
+					Team        *struct {
+						Key string `json:"key"`
+					} `json:"team"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"customViews"`
+		}
+		if err := c.do(ctx, query, vars, &resp); err != nil {
+			return nil, err
+		}
+		for _, node := range resp.CustomViews.Nodes {
+			view := CustomView{
+				ID:          node.ID,
+				Name:        node.Name,
+				Description: node.Description,
+				Shared:      node.Shared,
+			}
+			if node.Team != nil {
+				view.TeamKey = node.Team.Key
+			}
+			views = append(views, view)
+		}
+		pageInfo := resp.CustomViews.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+			return views, nil
+		}
+		vars["after"] = pageInfo.EndCursor
 	}
-	if err := c.do(ctx, query, map[string]any{"id": id, "input": trimmed}, &resp); err != nil {
-		return IssueSummary{}, err
+}
+
+func (c *Client) ResolveCustomViewID(ctx context.Context, value string) (string, error) {
+	if isLikelyID(value) {
+		return value, nil
 	}
-	if resp.IssueUpdate.Issue == nil {
-		return IssueSummary{}, ErrNotFound
+	views, err := c.CustomViews(ctx)
+	if err != nil {
+		return "", err
 	}
-	return *resp.IssueUpdate.Issue, nil
+	for _, view := range views {
+		if strings.EqualFold(view.Name, value) {
+			return view.ID, nil
+		}
+	}
+	return "", ErrNotFound
 }
 
-func (c *Client) IssueComment(ctx context.Context, issueID, body string) (string, error) {
-	query := `mutation($input: CommentCreateInput!) {
-  commentCreate(input: $input) {
-    comment { id }
+func (c *Client) CustomViewIssues(ctx context.Context, viewID string, filter IssueFilter, limit int, after string) (IssuePage, error) {
+	query := `query($id: String!, $filter: IssueFilter, $first: Int, $after: String, $includeArchived: Boolean) {
+  customView(id: $id) {
+    issues(filter: $filter, first: $first, after: $after, includeArchived: $includeArchived) {
+      nodes {
+        ` + issueSummaryFields + `
+      }
+      pageInfo { hasNextPage endCursor }
+    }
   }
 }`
+	vars := issuePageVariables(filter, limit, after)
+	vars["id"] = viewID
 	var resp struct {
-		CommentCreate struct {
-			Comment *struct {
-				ID string `json:"id"`
-			} `json:"comment"`
-		} `json:"commentCreate"`
+		CustomView *struct {
+			Issues issueConnection `json:"issues"`
+		} `json:"customView"`
 	}
-	if err := c.do(ctx, query, map[string]any{"input": map[string]any{"issueId": issueID, "body": body}}, &resp); err != nil {
-		return "", err
+	if err := c.do(ctx, query, vars, &resp); err != nil {
+		return IssuePage{}, err
 	}
-	if resp.CommentCreate.Comment == nil {
-		return "", ErrNotFound
+	if resp.CustomView == nil {
+		return IssuePage{}, ErrNotFound
 	}
-	return resp.CommentCreate.Comment.ID, nil
+	return resp.CustomView.Issues.page(), nil
 }
 
 func (c *Client) Cycles(ctx context.Context, teamID string, current bool, limit int, after string) (CyclePage, error) {
@@ -1118,12 +1337,15 @@ func (c *Client) Cycle(ctx context.Context, id string) (Cycle, error) {
 func buildIssueFilter(filter IssueFilter) map[string]any {
 	if filter.TeamID == "" &&
 		filter.AssigneeID == "" &&
+		!filter.Unassigned &&
 		filter.StateID == "" &&
 		len(filter.LabelIDs) == 0 &&
 		filter.ProjectID == "" &&
 		filter.CycleID == "" &&
 		filter.Search == "" &&
-		filter.Priority == nil {
+		filter.ParentID == "" &&
+		filter.SubscriberID == "" &&
+		len(filter.Priorities) == 0 {
 		return nil
 	}
 	out := map[string]any{}
@@ -1133,6 +1355,9 @@ func buildIssueFilter(filter IssueFilter) map[string]any {
 	if filter.AssigneeID != "" {
 		out["assignee"] = map[string]any{"id": map[string]any{"eq": filter.AssigneeID}}
 	}
+	if filter.Unassigned {
+		out["assignee"] = map[string]any{"null": true}
+	}
 	if filter.StateID != "" {
 		out["state"] = map[string]any{"id": map[string]any{"eq": filter.StateID}}
 	}
@@ -1148,8 +1373,20 @@ func buildIssueFilter(filter IssueFilter) map[string]any {
 	if filter.Search != "" {
 		out["title"] = map[string]any{"contains": filter.Search}
 	}
-	if filter.Priority != nil {
-		out["priority"] = map[string]any{"eq": *filter.Priority}
+	if filter.ParentID != "" {
+		out["parent"] = map[string]any{"id": map[string]any{"eq": filter.ParentID}}
+	}
+	if filter.SubscriberID != "" {
+		out["subscribers"] = map[string]any{"some": map[string]any{"id": map[string]any{"eq": filter.SubscriberID}}}
+	}
+	if len(filter.Priorities) == 1 {
+		out["priority"] = map[string]any{"eq": int(filter.Priorities[0])}
+	} else if len(filter.Priorities) > 1 {
+		values := make([]int, 0, len(filter.Priorities))
+		for _, p := range filter.Priorities {
+			values = append(values, int(p))
+		}
+		out["priority"] = map[string]any{"in": values}
 	}
 	return out
 }
@@ -0,0 +1,105 @@
+package linear
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const reactionFields = `id emoji user { id name }`
+
+type reactionNode struct {
+	ID    string     `json:"id"`
+	Emoji string     `json:"emoji"`
+	User  *namedNode `json:"user"`
+}
+
+func (n reactionNode) reaction() Reaction {
+	return Reaction{ID: n.ID, Emoji: n.Emoji, UserID: n.User.id(), UserName: n.User.name()}
+}
+
+func reactions(nodes []reactionNode) []Reaction {
+	if len(nodes) == 0 {
+		return nil
+	}
+	out := make([]Reaction, 0, len(nodes))
+	for _, node := range nodes {
+		out = append(out, node.reaction())
+	}
+	return out
+}
+
+func validateReaction(issueID, commentID, emoji string) error {
+	if (issueID == "") == (commentID == "") {
+		return errors.New("a reaction needs exactly one of an issue or a comment")
+	}
+	if emoji == "" {
+		return errors.New("reaction emoji is required")
+	}
+	return nil
+}
+
+// emojiSymbols maps the shortcodes Linear stores for reactions to the
+// characters used when printing them.
+var emojiSymbols = map[string]string{
+	"+1":               "👍",
+	"-1":               "👎",
+	"100":              "💯",
+	"bug":              "🐛",
+	"clap":             "👏",
+	"confused":         "😕",
+	"eyes":             "👀",
+	"fire":             "🔥",
+	"heart":            "❤️",
+	"joy":              "😂",
+	"ok_hand":          "👌",
+	"pray":             "🙏",
+	"raised_hands":     "🙌",
+	"rocket":           "🚀",
+	"smile":            "😄",
+	"tada":             "🎉",
+	"thinking_face":    "🤔",
+	"warning":          "⚠️",
+	"wave":             "👋",
+	"white_check_mark": "✅",
+	"x":                "❌",
+}
+
+var emojiAliases = map[string]string{
+	"thumbsup":   "+1",
+	"thumbsdown": "-1",
+	"thinking":   "thinking_face",
+	"check":      "white_check_mark",
+}
+
+var shortcodePattern = regexp.MustCompile(`^[a-z0-9_+-]+$`)
+
+// ParseEmoji accepts a shortcode with or without colons (":+1:", "eyes") or
+// a known emoji character, and returns the shortcode name.
+func ParseEmoji(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	for name, symbol := range emojiSymbols {
+		if value == symbol || value == strings.TrimSuffix(symbol, "\uFE0F") {
+			return name, nil
+		}
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(value, ":"), ":"))
+	if alias, ok := emojiAliases[name]; ok {
+		name = alias
+	}
+	if !shortcodePattern.MatchString(name) {
+		return "", fmt.Errorf("invalid emoji %q; use a shortcode such as :+1: or :eyes:", value)
+	}
+	return name, nil
+}
+
+// EmojiSymbol returns the character for a shortcode, or ":name:" when the
+// shortcode is not a known emoji (for example a custom workspace emoji).
+func EmojiSymbol(name string) string {
+	if symbol, ok := emojiSymbols[name]; ok {
+		return symbol
+	}
+	return ":" + name + ":"
+}
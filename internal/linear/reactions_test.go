@@ -0,0 +1,61 @@
+package linear
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseEmoji(t *testing.T) {
+	cases := map[string]string{
+		":+1:":           "+1",
+		"eyes":           "eyes",
+		":thumbsup:":     "+1",
+		"👀":              "eyes",
+		"❤️":             "heart",
+		"\u2764":         "heart",
+		":Party_Parrot:": "party_parrot",
+	}
+	for input, want := range cases {
+		got, err := ParseEmoji(input)
+		if err != nil {
+			t.Fatalf("ParseEmoji(%q) error: %v", input, err)
+		}
+		if got != want {
+			t.Fatalf("ParseEmoji(%q) = %q, want %q", input, got, want)
+		}
+	}
+	for _, input := range []string{"", "::", "not an emoji", "🦄"} {
+		if _, err := ParseEmoji(input); err == nil {
+			t.Fatalf("expected ParseEmoji(%q) to fail", input)
+		}
+	}
+}
+
+func TestEmojiSymbol(t *testing.T) {
+	if EmojiSymbol("+1") != "👍" {
+		t.Fatalf("unexpected symbol %q", EmojiSymbol("+1"))
+	}
+	if EmojiSymbol("party_parrot") != ":party_parrot:" {
+		t.Fatalf("unexpected symbol %q", EmojiSymbol("party_parrot"))
+	}
+}
+
+func TestDryRunReactionCreateValidatesTarget(t *testing.T) {
+	log := &MutationLog{}
+	api := DryRun(readOnlyAPI{}, log)
+
+	if _, err := api.ReactionCreate(context.Background(), "issue-1", "c1", "+1"); err == nil {
+		t.Fatal("expected a reaction with two targets to be rejected")
+	}
+	if _, err := api.ReactionCreate(context.Background(), "", "c1", "eyes"); err != nil {
+		t.Fatalf("ReactionCreate() error: %v", err)
+	}
+	mutations := log.Mutations()
+	if len(mutations) != 1 {
+		t.Fatalf("expected 1 mutation, got %d", len(mutations))
+	}
+	input := mutations[0].Variables["input"].(map[string]any)
+	if input["commentId"] != "c1" || input["emoji"] != "eyes" || input["issueId"] != nil {
+		t.Fatalf("unexpected variables %v", mutations[0].Variables)
+	}
+}
@@ -0,0 +1,36 @@
+package linear
+
+const (
+	RelationBlocks    = "blocks"
+	RelationDuplicate = "duplicate"
+	RelationRelated   = "related"
+	RelationSimilar   = "similar"
+)
+
+func (s IssueRelationSet) Links() []IssueLink {
+	links := make([]IssueLink, 0, len(s.Relations)+len(s.InverseRelations))
+	for _, rel := range s.Relations {
+		links = append(links, IssueLink{RelationID: rel.ID, Type: linkType(rel.Type, false), Issue: rel.RelatedIssue})
+	}
+	for _, rel := range s.InverseRelations {
+		links = append(links, IssueLink{RelationID: rel.ID, Type: linkType(rel.Type, true), Issue: rel.Issue})
+	}
+	return links
+}
+
+func linkType(relationType string, inverse bool) string {
+	switch relationType {
+	case RelationBlocks:
+		if inverse {
+			return "blocked_by"
+		}
+		return "blocks"
+	case RelationDuplicate:
+		if inverse {
+			return "duplicated_by"
+		}
+		return "duplicate_of"
+	default:
+		return relationType
+	}
+}
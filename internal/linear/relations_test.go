@@ -0,0 +1,33 @@
+package linear
+
+import "testing"
+
+func TestIssueRelationSetLinks(t *testing.T) {
+	set := IssueRelationSet{
+		Relations: []IssueRelation{
+			{ID: "r1", Type: RelationBlocks, RelatedIssue: IssueRef{Identifier: "ENG-2"}},
+			{ID: "r2", Type: RelationDuplicate, RelatedIssue: IssueRef{Identifier: "ENG-3"}},
+		},
+		InverseRelations: []IssueRelation{
+			{ID: "r3", Type: RelationBlocks, Issue: IssueRef{Identifier: "ENG-4"}},
+			{ID: "r4", Type: RelationRelated, Issue: IssueRef{Identifier: "ENG-5"}},
+			{ID: "r5", Type: RelationDuplicate, Issue: IssueRef{Identifier: "ENG-6"}},
+		},
+	}
+	expected := []struct{ typ, identifier string }{
+		{"blocks", "ENG-2"},
+		{"duplicate_of", "ENG-3"},
+		{"blocked_by", "ENG-4"},
+		{"related", "ENG-5"},
+		{"duplicated_by", "ENG-6"},
+	}
+	links := set.Links()
+	if len(links) != len(expected) {
+		t.Fatalf("expected %d links, got %d", len(expected), len(links))
+	}
+	for i, want := range expected {
+		if links[i].Type != want.typ || links[i].Issue.Identifier != want.identifier {
+			t.Fatalf("link %d: expected %s %s, got %+v", i, want.typ, want.identifier, links[i])
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package linear
 
+import "time"
+
 type User struct {
 	ID    string `json:"id"`
 	Name  string `json:"name"`
@@ -12,6 +14,32 @@ type Team struct {
 	Name string `json:"name"`
 }
 
+type Label struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	TeamID string `json:"team_id,omitempty"`
+}
+
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type IssueTemplate struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	TeamID      string    `json:"team_id,omitempty"`
+	TeamKey     string    `json:"team_key,omitempty"`
+	Title       string    `json:"title,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Priority    *Priority `json:"priority,omitempty"`
+	Estimate    *float64  `json:"estimate,omitempty"`
+	StateID     string    `json:"state_id,omitempty"`
+	AssigneeID  string    `json:"assignee_id,omitempty"`
+	ProjectID   string    `json:"project_id,omitempty"`
+	LabelIDs    []string  `json:"label_ids,omitempty"`
+}
+
 type WorkflowState struct {
 	ID   string `json:"id"`
 	Name string `json:"name"`
@@ -19,22 +47,48 @@ type WorkflowState struct {
 }
 
 type IssueSummary struct {
+	ID         string    `json:"id"`
+	Identifier string    `json:"identifier"`
+	Title      string    `json:"title"`
+	URL        string    `json:"url"`
+	State      string    `json:"state"`
+	StateType  string    `json:"state_type,omitempty"`
+	Assignee   string    `json:"assignee"`
+	TeamID     string    `json:"team_id,omitempty"`
+	TeamKey    string    `json:"team_key"`
+	Cycle      string    `json:"cycle"`
+	Project    string    `json:"project,omitempty"`
+	Priority   Priority  `json:"priority"`
+	Estimate   *float64  `json:"estimate,omitempty"`
+	DueDate    *Date     `json:"due_date,omitempty"`
+	Labels     []string  `json:"labels,omitempty"`
+	Creator    string    `json:"creator,omitempty"`
+	Parent     string    `json:"parent,omitempty"`
+	CreatedAt  time.Time `json:"created_at,omitzero"`
+	UpdatedAt  time.Time `json:"updated_at,omitzero"`
+}
+
+type IssueRelation struct {
+	ID             string   `json:"id"`
+	IssueID        string   `json:"issue_id"`
+	RelatedIssueID string   `json:"related_issue_id"`
+	Type           string   `json:"type"`
+	Issue          IssueRef `json:"issue"`
+	RelatedIssue   IssueRef `json:"related_issue"`
+}
+
+type IssueRef struct {
 	ID         string `json:"id"`
 	Identifier string `json:"identifier"`
 	Title      string `json:"title"`
-	URL        string `json:"url"`
 	State      string `json:"state"`
-	Assignee   string `json:"assignee"`
-	TeamKey    string `json:"team_key"`
-	Cycle      string `json:"cycle"`
-	Priority   int    `json:"priority"`
+	StateType  string `json:"state_type,omitempty"`
 }
 
-type IssueRelation struct {
-	ID             string `json:"id"`
-	IssueID        string `json:"issue_id"`
-	RelatedIssueID string `json:"related_issue_id"`
-	Type           string `json:"type"`
+type IssueLink struct {
+	RelationID string   `json:"relation_id"`
+	Type       string   `json:"type"`
+	Issue      IssueRef `json:"issue"`
 }
 
 type IssueRelationSet struct {
@@ -43,23 +97,45 @@ type IssueRelationSet struct {
 }
 
 type IssueDetail struct {
-	ID          string       `json:"id"`
-	Identifier  string       `json:"identifier"`
-	Title       string       `json:"title"`
-	URL         string       `json:"url"`
-	Description string       `json:"description"`
-	Priority    int          `json:"priority"`
-	State       string       `json:"state"`
-	Assignee    string       `json:"assignee"`
-	TeamID      string       `json:"team_id"`
-	TeamKey     string       `json:"team_key"`
-	Cycle       string       `json:"cycle"`
-	Project     string       `json:"project"`
-	Labels      []string     `json:"labels"`
-	Comments    []Comment    `json:"comments,omitempty"`
-	Uploads     []Attachment `json:"uploads,omitempty"`
-	CreatedAt   string       `json:"created_at"`
-	UpdatedAt   string       `json:"updated_at"`
+	ID            string         `json:"id"`
+	Identifier    string         `json:"identifier"`
+	Title         string         `json:"title"`
+	URL           string         `json:"url"`
+	Description   string         `json:"description"`
+	Priority      Priority       `json:"priority"`
+	State         string         `json:"state"`
+	StateType     string         `json:"state_type,omitempty"`
+	Assignee      string         `json:"assignee"`
+	TeamID        string         `json:"team_id"`
+	TeamKey       string         `json:"team_key"`
+	Cycle         string         `json:"cycle"`
+	Project       string         `json:"project"`
+	Labels        []string       `json:"labels"`
+	LabelIDs      []string       `json:"label_ids"`
+	StateID       string         `json:"state_id,omitempty"`
+	AssigneeID    string         `json:"assignee_id,omitempty"`
+	ProjectID     string         `json:"project_id,omitempty"`
+	CycleID       string         `json:"cycle_id,omitempty"`
+	ParentID      string         `json:"parent_id,omitempty"`
+	Estimate      *float64       `json:"estimate,omitempty"`
+	DueDate       *Date          `json:"due_date,omitempty"`
+	Creator       string         `json:"creator,omitempty"`
+	Parent        string         `json:"parent,omitempty"`
+	BranchName    string         `json:"branch_name,omitempty"`
+	Subscribers   []User         `json:"subscribers,omitempty"`
+	SubIssues     []IssueSummary `json:"sub_issues,omitempty"`
+	SLAType       string         `json:"sla_type,omitempty"`
+	SLAStartedAt  *time.Time     `json:"sla_started_at,omitempty"`
+	SLABreachesAt *time.Time     `json:"sla_breaches_at,omitempty"`
+	StartedAt     *time.Time     `json:"started_at,omitempty"`
+	CompletedAt   *time.Time     `json:"completed_at,omitempty"`
+	CanceledAt    *time.Time     `json:"canceled_at,omitempty"`
+	Relations     []IssueLink    `json:"relations,omitempty"`
+	Comments      []Comment      `json:"comments,omitempty"`
+	Uploads       []Attachment   `json:"uploads,omitempty"`
+	Reactions     []Reaction     `json:"reactions,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
 }
 
 type Comment struct {
@@ -69,27 +145,78 @@ type Comment struct {
 	CreatedAt string `json:"created_at,omitempty"`
 	UserName  string `json:"user_name,omitempty"`
 	UserEmail string `json:"user_email,omitempty"`
+
+	URL        string `json:"url,omitempty"`
+	EditedAt   string `json:"edited_at,omitempty"`
+	ParentID   string `json:"parent_id,omitempty"`
+	ResolvedAt string `json:"resolved_at,omitempty"`
+	ResolvedBy string `json:"resolved_by,omitempty"`
+	IssueID    string `json:"issue_id,omitempty"`
+	Issue      string `json:"issue,omitempty"`
+
+	Reactions []Reaction `json:"reactions,omitempty"`
+}
+
+type Reaction struct {
+	ID       string `json:"id"`
+	Emoji    string `json:"emoji"`
+	UserID   string `json:"user_id,omitempty"`
+	UserName string `json:"user_name,omitempty"`
+}
+
+type CommentPage struct {
+	Nodes    []Comment `json:"nodes"`
+	PageInfo PageInfo  `json:"page_info"`
+}
+
+type IssueHistoryEntry struct {
+	ID        string          `json:"id"`
+	CreatedAt time.Time       `json:"created_at"`
+	Actor     string          `json:"actor,omitempty"`
+	Changes   []HistoryChange `json:"changes"`
+}
+
+type HistoryChange struct {
+	Field   string   `json:"field"`
+	From    string   `json:"from,omitempty"`
+	To      string   `json:"to,omitempty"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+type IssueHistoryPage struct {
+	Nodes    []IssueHistoryEntry `json:"nodes"`
+	PageInfo PageInfo            `json:"page_info"`
 }
 
 type Attachment struct {
-	ID          string `json:"id"`
-	Title       string `json:"title,omitempty"`
-	URL         string `json:"url,omitempty"`
-	FileName    string `json:"file_name,omitempty"`
-	ContentType string `json:"content_type,omitempty"`
-	CreatedAt   string `json:"created_at,omitempty"`
-	CommentID   string `json:"comment_id,omitempty"`
+	ID          string         `json:"id"`
+	Title       string         `json:"title,omitempty"`
+	Subtitle    string         `json:"subtitle,omitempty"`
+	URL         string         `json:"url,omitempty"`
+	Source      string         `json:"source,omitempty"`
+	Metadata    map[string]any `json:"metadata,omitempty"`
+	FileName    string         `json:"file_name,omitempty"`
+	ContentType string         `json:"content_type,omitempty"`
+	CreatedAt   string         `json:"created_at,omitempty"`
+	CommentID   string         `json:"comment_id,omitempty"`
 }
 
 type IssueFilter struct {
 	TeamID     string
 	AssigneeID string
+	Unassigned bool
 	StateID    string
 	LabelIDs   []string
 	ProjectID  string
 	CycleID    string
 	Search     string
-	Priority   *int
+	ParentID   string
+	Priorities []Priority
+
+	SubscriberID string
+
+	IncludeArchived bool
 }
 
 type IssuePage struct {
@@ -97,6 +224,14 @@ type IssuePage struct {
 	PageInfo PageInfo       `json:"page_info"`
 }
 
+type CustomView struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	TeamKey     string `json:"team_key,omitempty"`
+	Shared      bool   `json:"shared"`
+}
+
 type Cycle struct {
 	ID       string `json:"id"`
 	Name     string `json:"name"`
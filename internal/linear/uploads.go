@@ -0,0 +1,79 @@
+package linear
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// MaxUploadSize is the largest file accepted by UploadFile.
+const MaxUploadSize int64 = 50 << 20
+
+type uploadFileNode struct {
+	UploadURL string `json:"uploadUrl"`
+	AssetURL  string `json:"assetUrl"`
+	Headers   []struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	} `json:"headers"`
+}
+
+func validateUpload(filename, contentType string, size int64) error {
+	if filename == "" {
+		return errors.New("upload filename is required")
+	}
+	if contentType == "" {
+		return errors.New("upload content type is required")
+	}
+	if size <= 0 {
+		return fmt.Errorf("%s is empty", filename)
+	}
+	if size > MaxUploadSize {
+		return fmt.Errorf("%s is larger than the %d MB upload limit", filename, MaxUploadSize>>20)
+	}
+	return nil
+}
+
+// UploadFile requests a signed upload URL, PUTs the bytes to it, and returns
+// the asset URL to reference from descriptions and comments.
+func (c *Client) UploadFile(ctx context.Context, filename, contentType string, size int64, body io.Reader) (string, error) {
+	if err := validateUpload(filename, contentType, size); err != nil {
+		return "", err
+	}
+	var resp struct {
+		FileUpload struct {
+			Success    bool            `json:"success"`
+			UploadFile *uploadFileNode `json:"uploadFile"`
+		} `json:"fileUpload"`
+	}
+	if err := c.mutate(ctx, fileUploadMutation(filename, contentType, size), &resp); err != nil {
+		return "", err
+	}
+	target := resp.FileUpload.UploadFile
+	if !resp.FileUpload.Success || target == nil || target.UploadURL == "" {
+		return "", errors.New("fileUpload failed")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, target.UploadURL, body)
+	if err != nil {
+		return "", fmt.Errorf("create upload request: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Cache-Control", "public, max-age=31536000")
+	for _, header := range target.Headers {
+		req.Header.Set(header.Key, header.Value)
+	}
+	res, err := c.upload.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("upload failed: %w", err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return "", fmt.Errorf("upload failed: %s", res.Status)
+	}
+	return target.AssetURL, nil
+}
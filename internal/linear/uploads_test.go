@@ -0,0 +1,116 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUploadFileRequestsURLAndPutsBytes(t *testing.T) {
+	var uploaded, contentType, extra string
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			data, _ := io.ReadAll(r.Body)
+			uploaded = string(data)
+			contentType = r.Header.Get("Content-Type")
+			extra = r.Header.Get("x-goog-meta-test")
+			if r.Header.Get("Authorization") != "" {
+				t.Errorf("expected the signed upload to omit the API token")
+			}
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		var req gqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if !strings.Contains(req.Query, "fileUpload(") {
+			t.Fatalf("unexpected query %q", req.Query)
+		}
+		if req.Variables["filename"] != "shot.png" || req.Variables["contentType"] != "image/png" || req.Variables["size"] != float64(5) {
+			t.Fatalf("unexpected variables %v", req.Variables)
+		}
+		_, _ = fmt.Fprintf(w, `{"data":{"fileUpload":{"success":true,"uploadFile":{"uploadUrl":"%s/put","assetUrl":"https://uploads.linear.app/a/b/c","headers":[{"key":"x-goog-meta-test","value":"1"}]}}}}`, srv.URL)
+	}))
+	defer srv.Close()
+
+	client := &Client{apiURL: srv.URL, token: "test-key", http: srv.Client(), upload: srv.Client()}
+	assetURL, err := client.UploadFile(context.Background(), "shot.png", "image/png", 5, strings.NewReader("bytes"))
+	if err != nil {
+		t.Fatalf("UploadFile() error: %v", err)
+	}
+	if assetURL != "https://uploads.linear.app/a/b/c" {
+		t.Fatalf("unexpected asset URL %q", assetURL)
+	}
+	if uploaded != "bytes" || contentType != "image/png" || extra != "1" {
+		t.Fatalf("unexpected upload %q %q %q", uploaded, contentType, extra)
+	}
+}
+
+func TestUploadFileReportsFailedPut(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"data":{"fileUpload":{"success":true,"uploadFile":{"uploadUrl":"%s/put","assetUrl":"https://uploads.linear.app/a/b/c","headers":[]}}}}`, srv.URL)
+	}))
+	defer srv.Close()
+
+	client := &Client{apiURL: srv.URL, http: srv.Client(), upload: srv.Client()}
+	_, err := client.UploadFile(context.Background(), "notes.txt", "text/plain", 3, strings.NewReader("abc"))
+	if err == nil || !strings.Contains(err.Error(), "403") {
+		t.Fatalf("expected upload failure, got %v", err)
+	}
+}
+
+func TestUploadFileIgnoresAPITimeout(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			time.Sleep(100 * time.Millisecond)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"data":{"fileUpload":{"success":true,"uploadFile":{"uploadUrl":"%s/put","assetUrl":"https://uploads.linear.app/a/b/c","headers":[]}}}}`, srv.URL)
+	}))
+	defer srv.Close()
+
+	client := NewClient("test-key", 50*time.Millisecond).(*Client)
+	client.apiURL = srv.URL
+	if _, err := client.UploadFile(context.Background(), "big.bin", "application/octet-stream", 3, strings.NewReader("abc")); err != nil {
+		t.Fatalf("expected the upload to outlast the API timeout, got %v", err)
+	}
+}
+
+func TestDryRunUploadFileValidatesSize(t *testing.T) {
+	log := &MutationLog{}
+	api := DryRun(readOnlyAPI{}, log)
+
+	if _, err := api.UploadFile(context.Background(), "empty.txt", "text/plain", 0, nil); err == nil {
+		t.Fatal("expected an empty file to be rejected")
+	}
+	if _, err := api.UploadFile(context.Background(), "huge.bin", "application/octet-stream", MaxUploadSize+1, nil); err == nil {
+		t.Fatal("expected an oversized file to be rejected")
+	}
+	assetURL, err := api.UploadFile(context.Background(), "shot.png", "image/png", 10, nil)
+	if err != nil {
+		t.Fatalf("UploadFile() error: %v", err)
+	}
+	if !strings.Contains(assetURL, DryRunID) {
+		t.Fatalf("expected placeholder asset URL, got %q", assetURL)
+	}
+	mutations := log.Mutations()
+	if len(mutations) != 1 || mutations[0].Variables["filename"] != "shot.png" {
+		t.Fatalf("unexpected mutations %v", mutations)
+	}
+}
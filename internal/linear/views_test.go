@@ -0,0 +1,88 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCustomViewIssuesResolvesByName(t *testing.T) {
+	var issuesVars map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req gqlRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch {
+		case strings.Contains(req.Query, "customViews("):
+			// The match is on the second page, so resolving it needs pagination.
+			views := map[string]any{
+				"nodes":    []map[string]any{{"id": "view-1", "name": "Backlog", "shared": true}},
+				"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "views-1"},
+			}
+			if req.Variables["after"] == "views-1" {
+				views = map[string]any{
+					"nodes":    []map[string]any{{"id": "view-2", "name": "Sprint board", "shared": true, "team": map[string]any{"key": "ENG"}}},
+					"pageInfo": map[string]any{"hasNextPage": false},
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"customViews": views}})
+		case strings.Contains(req.Query, "customView(id:"):
+			issuesVars = req.Variables
+			resp := map[string]any{
+				"data": map[string]any{
+					"customView": map[string]any{
+						"issues": map[string]any{
+							"nodes": []map[string]any{
+								{
+									"id":         "issue-1",
+									"identifier": "ENG-1",
+									"title":      "First",
+									"state":      map[string]any{"name": "Todo"},
+									"team":       map[string]any{"key": "ENG"},
+								},
+							},
+							"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "cursor-1"},
+						},
+					},
+				},
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	client := &Client{
+		apiURL: srv.URL,
+		http:   srv.Client(),
+	}
+
+	viewID, err := client.ResolveCustomViewID(context.Background(), "sprint board")
+	if err != nil {
+		t.Fatalf("ResolveCustomViewID() error: %v", err)
+	}
+	if viewID != "view-2" {
+		t.Fatalf("expected view-2, got %s", viewID)
+	}
+
+	page, err := client.CustomViewIssues(context.Background(), viewID, IssueFilter{}, 10, "")
+	if err != nil {
+		t.Fatalf("CustomViewIssues() error: %v", err)
+	}
+	if issuesVars["id"] != "view-2" {
+		t.Fatalf("expected view id variable, got %v", issuesVars["id"])
+	}
+	if len(page.Nodes) != 1 || page.Nodes[0].Identifier != "ENG-1" {
+		t.Fatalf("unexpected nodes: %+v", page.Nodes)
+	}
+	if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor != "cursor-1" {
+		t.Fatalf("unexpected page info: %+v", page.PageInfo)
+	}
+}